@@ -2,11 +2,11 @@ package controller
 
 import (
 	"net/http"
-	"strconv"
 	"strings"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/gin-gonic/gin"
@@ -18,6 +18,9 @@ type PosCashDrawerController interface {
 	HandleUpdatePosCashDrawerRequest(c *gin.Context)
 	HandleDeletePosCashDrawerRequest(c *gin.Context)
 	HandleReadAllPosCashDrawersRequest(c *gin.Context)
+	HandleOpenDrawerSessionRequest(c *gin.Context)
+	HandleCloseDrawerSessionRequest(c *gin.Context)
+	HandleReadAllDrawerSessionsRequest(c *gin.Context)
 }
 
 type posCashDrawerController struct {
@@ -35,14 +38,14 @@ func (p *posCashDrawerController) HandleCreatePosCashDrawerRequest(ctx *gin.Cont
 
 	if err := ctx.ShouldBindJSON(&req.PosCashDrawer); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_CASH_DRAWER, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_CASH_DRAWER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -51,7 +54,7 @@ func (p *posCashDrawerController) HandleCreatePosCashDrawerRequest(ctx *gin.Cont
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -62,7 +65,7 @@ func (p *posCashDrawerController) HandleCreatePosCashDrawerRequest(ctx *gin.Cont
 	res, err := p.service.CreatePosCashDrawer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_CASH_DRAWER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -79,7 +82,7 @@ func (p *posCashDrawerController) HandleReadPosCashDrawerRequest(ctx *gin.Contex
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CASH_DRAWER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -88,7 +91,7 @@ func (p *posCashDrawerController) HandleReadPosCashDrawerRequest(ctx *gin.Contex
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -99,7 +102,7 @@ func (p *posCashDrawerController) HandleReadPosCashDrawerRequest(ctx *gin.Contex
 	res, err := p.service.ReadPosCashDrawer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CASH_DRAWER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -112,14 +115,14 @@ func (p *posCashDrawerController) HandleUpdatePosCashDrawerRequest(ctx *gin.Cont
 	drawerID := ctx.Param("id")
 	if err := ctx.ShouldBindJSON(&req.PosCashDrawer); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_CASH_DRAWER, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_CASH_DRAWER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -128,7 +131,7 @@ func (p *posCashDrawerController) HandleUpdatePosCashDrawerRequest(ctx *gin.Cont
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -139,7 +142,7 @@ func (p *posCashDrawerController) HandleUpdatePosCashDrawerRequest(ctx *gin.Cont
 	res, err := p.service.UpdatePosCashDrawer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_CASH_DRAWER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -156,7 +159,7 @@ func (p *posCashDrawerController) HandleDeletePosCashDrawerRequest(ctx *gin.Cont
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_CASH_DRAWER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -165,7 +168,7 @@ func (p *posCashDrawerController) HandleDeletePosCashDrawerRequest(ctx *gin.Cont
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -176,7 +179,7 @@ func (p *posCashDrawerController) HandleDeletePosCashDrawerRequest(ctx *gin.Cont
 	_, err := p.service.DeletePosCashDrawer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_CASH_DRAWER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -185,49 +188,42 @@ func (p *posCashDrawerController) HandleDeletePosCashDrawerRequest(ctx *gin.Cont
 }
 
 func (p *posCashDrawerController) HandleReadAllPosCashDrawersRequest(ctx *gin.Context) {
-	limitQuery := ctx.Query("limit")
-	pageQuery := ctx.Query("page")
-
-	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
-		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", pb.CreatePosCashDrawerResponse{})
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	var req pb.ReadAllPosCashDrawersRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
 
-	if limitQuery != "" && pageQuery != "" {
-		limit, err := strconv.Atoi(limitQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		page, err := strconv.Atoi(pageQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		getJwtPayload, exist := ctx.Get("user")
-		if !exist {
-			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CASH_DRAWER, "Jwt Payload is Empty", nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		req = pb.ReadAllPosCashDrawersRequest{
-			Limit:      int32(limit),
-			Page:       int32(page),
-			JwtPayload: getJwtPayload.(*pb.JWTPayload),
-		}
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CASH_DRAWER, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
 	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	// Every other query parameter is a filter, the server checks which ones the records have
+	listQuery, err := utils.ParseListQuery(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid list query", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+	req.Query = listQuery
+
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -237,10 +233,138 @@ func (p *posCashDrawerController) HandleReadAllPosCashDrawersRequest(ctx *gin.Co
 	res, err := p.service.ReadAllPosCashDrawers(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CASH_DRAWER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_CASH_DRAWER, res)
 	ctx.JSON(http.StatusOK, successResponse)
 }
+
+func (p *posCashDrawerController) HandleOpenDrawerSessionRequest(ctx *gin.Context) {
+	var req pb.OpenDrawerSessionRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_OPEN_DRAWER_SESSION, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_OPEN_DRAWER_SESSION, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := p.service.OpenDrawerSession(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_OPEN_DRAWER_SESSION, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_OPEN_DRAWER_SESSION, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (p *posCashDrawerController) HandleCloseDrawerSessionRequest(ctx *gin.Context) {
+	var req pb.CloseDrawerSessionRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CLOSE_DRAWER_SESSION, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Get session ID from URL
+	req.SessionId = ctx.Param("id")
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CLOSE_DRAWER_SESSION, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := p.service.CloseDrawerSession(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CLOSE_DRAWER_SESSION, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CLOSE_DRAWER_SESSION, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (p *posCashDrawerController) HandleReadAllDrawerSessionsRequest(ctx *gin.Context) {
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_DRAWER_SESSION, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req := pb.ReadAllDrawerSessionsRequest{
+		Limit:         paging.Limit,
+		Page:          paging.Page,
+		PageToken:     paging.PageToken,
+		EstimateCount: paging.EstimateCount,
+		JwtPayload:    getJwtPayload.(*pb.JWTPayload),
+	}
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+	res, err := p.service.ReadAllDrawerSessions(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_DRAWER_SESSION, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_DRAWER_SESSION, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
@@ -2,12 +2,12 @@ package controller
 
 import (
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/gin-gonic/gin"
@@ -36,14 +36,14 @@ func (p *posCustomerController) HandleCreatePosCustomerRequest(ctx *gin.Context)
 
 	if err := ctx.ShouldBindJSON(&req.PosCustomer); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_CUSTOMER, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	birthOfDate, err := time.Parse("2006-01-02", req.PosCustomer.DateOfBirth)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_CUSTOMER, "Invalid birth of date format", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -52,7 +52,7 @@ func (p *posCustomerController) HandleCreatePosCustomerRequest(ctx *gin.Context)
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_CUSTOMER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -61,7 +61,7 @@ func (p *posCustomerController) HandleCreatePosCustomerRequest(ctx *gin.Context)
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -72,7 +72,7 @@ func (p *posCustomerController) HandleCreatePosCustomerRequest(ctx *gin.Context)
 	res, err := p.service.CreatePosCustomer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_CUSTOMER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -89,7 +89,7 @@ func (p *posCustomerController) HandleReadPosCustomerRequest(ctx *gin.Context) {
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CUSTOMER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -98,7 +98,7 @@ func (p *posCustomerController) HandleReadPosCustomerRequest(ctx *gin.Context) {
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -109,7 +109,7 @@ func (p *posCustomerController) HandleReadPosCustomerRequest(ctx *gin.Context) {
 	res, err := p.service.ReadPosCustomer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CUSTOMER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -122,14 +122,14 @@ func (p *posCustomerController) HandleUpdatePosCustomerRequest(ctx *gin.Context)
 	customerID := ctx.Param("id")
 	if err := ctx.ShouldBindJSON(&req.PosCustomer); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_CUSTOMER, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_CUSTOMER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -138,7 +138,7 @@ func (p *posCustomerController) HandleUpdatePosCustomerRequest(ctx *gin.Context)
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -149,7 +149,7 @@ func (p *posCustomerController) HandleUpdatePosCustomerRequest(ctx *gin.Context)
 	res, err := p.service.UpdatePosCustomer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_CUSTOMER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -166,7 +166,7 @@ func (p *posCustomerController) HandleDeletePosCustomerRequest(ctx *gin.Context)
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_CUSTOMER, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -175,7 +175,7 @@ func (p *posCustomerController) HandleDeletePosCustomerRequest(ctx *gin.Context)
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -186,7 +186,7 @@ func (p *posCustomerController) HandleDeletePosCustomerRequest(ctx *gin.Context)
 	_, err := p.service.DeletePosCustomer(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_CUSTOMER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -195,49 +195,42 @@ func (p *posCustomerController) HandleDeletePosCustomerRequest(ctx *gin.Context)
 }
 
 func (p *posCustomerController) HandleReadAllPosCustomersRequest(ctx *gin.Context) {
-	limitQuery := ctx.Query("limit")
-	pageQuery := ctx.Query("page")
-
-	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
-		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", pb.CreatePosCustomerResponse{})
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	var req pb.ReadAllPosCustomersRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
 
-	if limitQuery != "" && pageQuery != "" {
-		limit, err := strconv.Atoi(limitQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		page, err := strconv.Atoi(pageQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		getJwtPayload, exist := ctx.Get("user")
-		if !exist {
-			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CUSTOMER, "Jwt Payload is Empty", nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		req = pb.ReadAllPosCustomersRequest{
-			Limit:      int32(limit),
-			Page:       int32(page),
-			JwtPayload: getJwtPayload.(*pb.JWTPayload),
-		}
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CUSTOMER, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
 	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	// Every other query parameter is a filter, the server checks which ones the records have
+	listQuery, err := utils.ParseListQuery(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid list query", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+	req.Query = listQuery
+
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -247,7 +240,7 @@ func (p *posCustomerController) HandleReadAllPosCustomersRequest(ctx *gin.Contex
 	res, err := p.service.ReadAllPosCustomers(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CUSTOMER, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
 		return
 	}
 
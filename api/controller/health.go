@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/health"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/gin-gonic/gin"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// readinessTimeout bounds every health call the readiness endpoint makes to the backend
+const readinessTimeout = 2 * time.Second
+
+type HealthController interface {
+	HandleLivenessRequest(c *gin.Context)
+	HandleReadinessRequest(c *gin.Context)
+}
+
+type healthController struct {
+	service healthpb.HealthClient
+}
+
+func NewHealthController(service healthpb.HealthClient) HealthController {
+	return &healthController{
+		service: service,
+	}
+}
+
+// HandleLivenessRequest only tells that the gateway process answers, it does not call the backend
+func (c *healthController) HandleLivenessRequest(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, utils.BuildResponseSuccess(dto.MESSAGE_SERVICE_ALIVE, nil))
+}
+
+// HandleReadinessRequest asks the backend gRPC health service for its overall status and every dependency
+func (c *healthController) HandleReadinessRequest(ctx *gin.Context) {
+	overall := c.check(ctx.Request.Context(), "")
+
+	resp := dto.ReadinessResponse{
+		Status:       overall.Status,
+		Dependencies: make([]dto.DependencyStatus, 0, len(health.DEPENDENCIES)),
+	}
+	for _, name := range health.DEPENDENCIES {
+		resp.Dependencies = append(resp.Dependencies, c.check(ctx.Request.Context(), name))
+	}
+
+	if overall.Status != healthpb.HealthCheckResponse_SERVING.String() {
+		ctx.JSON(http.StatusServiceUnavailable, utils.BuildResponseFailed(dto.MESSAGE_SERVICE_NOT_READY, overall.Error, resp).WithRequestID(ctx))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utils.BuildResponseSuccess(dto.MESSAGE_SERVICE_READY, resp))
+}
+
+func (c *healthController) check(parent context.Context, name string) dto.DependencyStatus {
+	ctx, cancel := context.WithTimeout(parent, readinessTimeout)
+	defer cancel()
+
+	status := dto.DependencyStatus{Name: name}
+	resp, err := c.service.Check(ctx, &healthpb.HealthCheckRequest{Service: name})
+	if err != nil {
+		status.Status = healthpb.HealthCheckResponse_UNKNOWN.String()
+		status.Error = err.Error()
+		return status
+	}
+
+	status.Status = resp.GetStatus().String()
+	return status
+}
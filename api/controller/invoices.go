@@ -2,11 +2,11 @@ package controller
 
 import (
 	"net/http"
-	"strconv"
 	"strings"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/gin-gonic/gin"
@@ -35,14 +35,14 @@ func (p *posInvoiceController) HandleCreatePosInvoiceRequest(ctx *gin.Context) {
 
 	if err := ctx.ShouldBindJSON(&req.PosInvoice); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_INVOICES, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_INVOICES, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -51,7 +51,7 @@ func (p *posInvoiceController) HandleCreatePosInvoiceRequest(ctx *gin.Context) {
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -62,7 +62,7 @@ func (p *posInvoiceController) HandleCreatePosInvoiceRequest(ctx *gin.Context) {
 	res, err := p.service.CreatePosInvoice(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_INVOICES, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -79,7 +79,7 @@ func (p *posInvoiceController) HandleReadPosInvoiceRequest(ctx *gin.Context) {
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_INVOICES, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -88,7 +88,7 @@ func (p *posInvoiceController) HandleReadPosInvoiceRequest(ctx *gin.Context) {
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -99,7 +99,7 @@ func (p *posInvoiceController) HandleReadPosInvoiceRequest(ctx *gin.Context) {
 	res, err := p.service.ReadPosInvoice(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVOICES, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -112,14 +112,14 @@ func (p *posInvoiceController) HandleUpdatePosInvoiceRequest(ctx *gin.Context) {
 	invoiceID := ctx.Param("id")
 	if err := ctx.ShouldBindJSON(&req.PosInvoice); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_INVOICES, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_INVOICES, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -128,7 +128,7 @@ func (p *posInvoiceController) HandleUpdatePosInvoiceRequest(ctx *gin.Context) {
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -139,7 +139,7 @@ func (p *posInvoiceController) HandleUpdatePosInvoiceRequest(ctx *gin.Context) {
 	res, err := p.service.UpdatePosInvoice(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_INVOICES, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -156,7 +156,7 @@ func (p *posInvoiceController) HandleDeletePosInvoiceRequest(ctx *gin.Context) {
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_INVOICES, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -165,7 +165,7 @@ func (p *posInvoiceController) HandleDeletePosInvoiceRequest(ctx *gin.Context) {
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -176,7 +176,7 @@ func (p *posInvoiceController) HandleDeletePosInvoiceRequest(ctx *gin.Context) {
 	_, err := p.service.DeletePosInvoice(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_INVOICES, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -185,49 +185,42 @@ func (p *posInvoiceController) HandleDeletePosInvoiceRequest(ctx *gin.Context) {
 }
 
 func (p *posInvoiceController) HandleReadAllPosInvoicesRequest(ctx *gin.Context) {
-	limitQuery := ctx.Query("limit")
-	pageQuery := ctx.Query("page")
-
-	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
-		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", pb.CreatePosInvoiceResponse{})
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	var req pb.ReadAllPosInvoicesRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVOICES, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
 
-	if limitQuery != "" && pageQuery != "" {
-		limit, err := strconv.Atoi(limitQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		page, err := strconv.Atoi(pageQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		getJwtPayload, exist := ctx.Get("user")
-		if !exist {
-			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVOICES, "Jwt Payload is Empty", nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		req = pb.ReadAllPosInvoicesRequest{
-			Limit:      int32(limit),
-			Page:       int32(page),
-			JwtPayload: getJwtPayload.(*pb.JWTPayload),
-		}
+	// Every other query parameter is a filter, the server checks which ones the records have
+	listQuery, err := utils.ParseListQuery(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid list query", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
 	}
+	req.Query = listQuery
+
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -237,7 +230,7 @@ func (p *posInvoiceController) HandleReadAllPosInvoicesRequest(ctx *gin.Context)
 	res, err := p.service.ReadAllPosInvoices(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVOICES, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -2,11 +2,11 @@ package controller
 
 import (
 	"net/http"
-	"strconv"
 	"strings"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/gin-gonic/gin"
@@ -35,14 +35,14 @@ func (p *posOnlinePaymentController) HandleCreatePosOnlinePaymentRequest(ctx *gi
 
 	if err := ctx.ShouldBindJSON(&req.PosOnlinePayment); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_ONLINE_PAYMENT, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_ONLINE_PAYMENT, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -51,7 +51,7 @@ func (p *posOnlinePaymentController) HandleCreatePosOnlinePaymentRequest(ctx *gi
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -62,7 +62,7 @@ func (p *posOnlinePaymentController) HandleCreatePosOnlinePaymentRequest(ctx *gi
 	res, err := p.service.CreatePosOnlinePayment(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_ONLINE_PAYMENT, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -79,7 +79,7 @@ func (p *posOnlinePaymentController) HandleReadPosOnlinePaymentRequest(ctx *gin.
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_ONLINE_PAYMENT, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -88,7 +88,7 @@ func (p *posOnlinePaymentController) HandleReadPosOnlinePaymentRequest(ctx *gin.
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -99,7 +99,7 @@ func (p *posOnlinePaymentController) HandleReadPosOnlinePaymentRequest(ctx *gin.
 	res, err := p.service.ReadPosOnlinePayment(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_ONLINE_PAYMENT, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -112,14 +112,14 @@ func (p *posOnlinePaymentController) HandleUpdatePosOnlinePaymentRequest(ctx *gi
 	paymentID := ctx.Param("id")
 	if err := ctx.ShouldBindJSON(&req.PosOnlinePayment); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_ONLINE_PAYMENT, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_ONLINE_PAYMENT, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -128,7 +128,7 @@ func (p *posOnlinePaymentController) HandleUpdatePosOnlinePaymentRequest(ctx *gi
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -139,7 +139,7 @@ func (p *posOnlinePaymentController) HandleUpdatePosOnlinePaymentRequest(ctx *gi
 	res, err := p.service.UpdatePosOnlinePayment(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_ONLINE_PAYMENT, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -156,7 +156,7 @@ func (p *posOnlinePaymentController) HandleDeletePosOnlinePaymentRequest(ctx *gi
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_ONLINE_PAYMENT, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -165,7 +165,7 @@ func (p *posOnlinePaymentController) HandleDeletePosOnlinePaymentRequest(ctx *gi
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -176,7 +176,7 @@ func (p *posOnlinePaymentController) HandleDeletePosOnlinePaymentRequest(ctx *gi
 	_, err := p.service.DeletePosOnlinePayment(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_ONLINE_PAYMENT, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -185,49 +185,42 @@ func (p *posOnlinePaymentController) HandleDeletePosOnlinePaymentRequest(ctx *gi
 }
 
 func (p *posOnlinePaymentController) HandleReadAllPosOnlinePaymentsRequest(ctx *gin.Context) {
-	limitQuery := ctx.Query("limit")
-	pageQuery := ctx.Query("page")
-
-	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
-		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", pb.CreatePosOnlinePaymentResponse{})
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	var req pb.ReadAllPosOnlinePaymentsRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
 
-	if limitQuery != "" && pageQuery != "" {
-		limit, err := strconv.Atoi(limitQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		page, err := strconv.Atoi(pageQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		getJwtPayload, exist := ctx.Get("user")
-		if !exist {
-			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_ONLINE_PAYMENT, "Jwt Payload is Empty", nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		req = pb.ReadAllPosOnlinePaymentsRequest{
-			Limit:      int32(limit),
-			Page:       int32(page),
-			JwtPayload: getJwtPayload.(*pb.JWTPayload),
-		}
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_ONLINE_PAYMENT, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
 	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	// Every other query parameter is a filter, the server checks which ones the records have
+	listQuery, err := utils.ParseListQuery(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid list query", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+	req.Query = listQuery
+
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -237,7 +230,7 @@ func (p *posOnlinePaymentController) HandleReadAllPosOnlinePaymentsRequest(ctx *
 	res, err := p.service.ReadAllPosOnlinePayments(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_ONLINE_PAYMENT, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
 		return
 	}
 
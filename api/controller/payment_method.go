@@ -2,11 +2,11 @@ package controller
 
 import (
 	"net/http"
-	"strconv"
 	"strings"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/gin-gonic/gin"
@@ -37,7 +37,7 @@ func (c *posPaymentMethodController) HandleCreatePosPaymentMethodRequest(ctx *gi
 	// First, binding payment method data
 	if err := ctx.ShouldBindJSON(&req.PosPaymentMethod); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PAYMENT_METHOD, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -45,7 +45,7 @@ func (c *posPaymentMethodController) HandleCreatePosPaymentMethodRequest(ctx *gi
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PAYMENT_METHOD, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -54,7 +54,7 @@ func (c *posPaymentMethodController) HandleCreatePosPaymentMethodRequest(ctx *gi
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -66,7 +66,7 @@ func (c *posPaymentMethodController) HandleCreatePosPaymentMethodRequest(ctx *gi
 	res, err := c.service.CreatePosPaymentMethod(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PAYMENT_METHOD, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -86,7 +86,7 @@ func (c *posPaymentMethodController) HandleReadPosPaymentMethodRequest(ctx *gin.
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PAYMENT_METHOD, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -95,7 +95,7 @@ func (c *posPaymentMethodController) HandleReadPosPaymentMethodRequest(ctx *gin.
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -106,7 +106,7 @@ func (c *posPaymentMethodController) HandleReadPosPaymentMethodRequest(ctx *gin.
 	res, err := c.service.ReadPosPaymentMethod(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PAYMENT_METHOD, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -119,7 +119,7 @@ func (c *posPaymentMethodController) HandleUpdatePosPaymentMethodRequest(ctx *gi
 	paymentMethodID := ctx.Param("id")
 	if err := ctx.ShouldBindJSON(&req.PosPaymentMethod); err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PAYMENT_METHOD, err.Error(), nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -127,7 +127,7 @@ func (c *posPaymentMethodController) HandleUpdatePosPaymentMethodRequest(ctx *gi
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PAYMENT_METHOD, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -136,7 +136,7 @@ func (c *posPaymentMethodController) HandleUpdatePosPaymentMethodRequest(ctx *gi
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -147,7 +147,7 @@ func (c *posPaymentMethodController) HandleUpdatePosPaymentMethodRequest(ctx *gi
 	res, err := c.service.UpdatePosPaymentMethod(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PAYMENT_METHOD, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -166,7 +166,7 @@ func (c *posPaymentMethodController) HandleDeletePosPaymentMethodRequest(ctx *gi
 	getJwtPayload, exist := ctx.Get("user")
 	if !exist {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PAYMENT_METHOD, "Jwt Payload is Empty", nil)
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -175,7 +175,7 @@ func (c *posPaymentMethodController) HandleDeletePosPaymentMethodRequest(ctx *gi
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -186,7 +186,7 @@ func (c *posPaymentMethodController) HandleDeletePosPaymentMethodRequest(ctx *gi
 	res, err := c.service.DeletePosPaymentMethod(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PAYMENT_METHOD, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -195,51 +195,33 @@ func (c *posPaymentMethodController) HandleDeletePosPaymentMethodRequest(ctx *gi
 }
 
 func (c *posPaymentMethodController) HandleReadAllPosPaymentMethodsRequest(ctx *gin.Context) {
-	limitQuery := ctx.Query("limit")
-	pageQuery := ctx.Query("page")
-
-	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
-		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", pb.CreatePosPaymentMethodResponse{})
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
 		return
 	}
 
 	var req pb.ReadAllPosPaymentMethodsRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
 
-	if limitQuery != "" && pageQuery != "" {
-		limit, err := strconv.Atoi(limitQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		page, err := strconv.Atoi(pageQuery)
-		if err != nil {
-			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		// Get JWT Payload data from middleware
-		getJwtPayload, exist := ctx.Get("user")
-		if !exist {
-			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PAYMENT_METHOD, "Jwt Payload is Empty", nil)
-			ctx.JSON(http.StatusBadRequest, errorResponse)
-			return
-		}
-
-		req = pb.ReadAllPosPaymentMethodsRequest{
-			Limit:      int32(limit),
-			Page:       int32(page),
-			JwtPayload: getJwtPayload.(*pb.JWTPayload),
-		}
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PAYMENT_METHOD, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
 	}
 
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
 	authHeader := ctx.GetHeader("Authorization")
 	bearerToken := strings.Split(authHeader, " ")
 	if len(bearerToken) != 2 {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
 		ctx.Abort()
 		return
 	}
@@ -250,7 +232,7 @@ func (c *posPaymentMethodController) HandleReadAllPosPaymentMethodsRequest(ctx *
 	res, err := c.service.ReadAllPosPaymentMethods(ctx, &req)
 	if err != nil {
 		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PAYMENT_METHOD, err.Error(), nil)
-		ctx.JSON(http.StatusInternalServerError, errorResponse)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
 		return
 	}
 
@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosPromotionRuleController interface {
+	HandleCreatePosPromotionRuleRequest(c *gin.Context)
+	HandleReadPosPromotionRuleRequest(c *gin.Context)
+	HandleUpdatePosPromotionRuleRequest(c *gin.Context)
+	HandleDeletePosPromotionRuleRequest(c *gin.Context)
+	HandleReadAllPosPromotionRulesRequest(c *gin.Context)
+}
+
+type posPromotionRuleController struct {
+	service pb.PosPromotionRuleServiceClient
+}
+
+func NewPosPromotionRuleController(service pb.PosPromotionRuleServiceClient) PosPromotionRuleController {
+	return &posPromotionRuleController{
+		service: service,
+	}
+}
+
+func (c *posPromotionRuleController) HandleCreatePosPromotionRuleRequest(ctx *gin.Context) {
+	// Declare req body Pos Promotion Rule
+	var req pb.CreatePosPromotionRuleRequest
+
+	// First, binding promotion rule data
+	if err := ctx.ShouldBindJSON(&req.PosPromotionRule); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PROMOTION_RULE, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PROMOTION_RULE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	// Service call
+	res, err := c.service.CreatePosPromotionRule(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PROMOTION_RULE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Success response
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_PROMOTION_RULE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posPromotionRuleController) HandleReadPosPromotionRuleRequest(ctx *gin.Context) {
+	var req pb.ReadPosPromotionRuleRequest
+
+	// Get promotion rule ID from URL
+	promotionRuleID := ctx.Param("id")
+	req.PromotionRuleId = promotionRuleID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PROMOTION_RULE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := c.service.ReadPosPromotionRule(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PROMOTION_RULE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_PROMOTION_RULE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posPromotionRuleController) HandleUpdatePosPromotionRuleRequest(ctx *gin.Context) {
+	var req pb.UpdatePosPromotionRuleRequest
+	promotionRuleID := ctx.Param("id")
+	if err := ctx.ShouldBindJSON(&req.PosPromotionRule); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PROMOTION_RULE, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PROMOTION_RULE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+	req.PosPromotionRule.PromotionRuleId = promotionRuleID
+	res, err := c.service.UpdatePosPromotionRule(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PROMOTION_RULE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_PROMOTION_RULE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posPromotionRuleController) HandleDeletePosPromotionRuleRequest(ctx *gin.Context) {
+	var req pb.DeletePosPromotionRuleRequest
+
+	// Get promotion rule ID from URL
+	promotionRuleID := ctx.Param("id")
+	req.PromotionRuleId = promotionRuleID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PROMOTION_RULE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := c.service.DeletePosPromotionRule(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PROMOTION_RULE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_DELETE_PROMOTION_RULE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posPromotionRuleController) HandleReadAllPosPromotionRulesRequest(ctx *gin.Context) {
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	var req pb.ReadAllPosPromotionRulesRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PROMOTION_RULE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := c.service.ReadAllPosPromotionRules(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PROMOTION_RULE, err.Error(), nil)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_PROMOTION_RULE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
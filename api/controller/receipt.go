@@ -0,0 +1,192 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosReceiptController interface {
+	HandleCreatePosReceiptRequest(c *gin.Context)
+	HandleReadPosReceiptRequest(c *gin.Context)
+	HandleVoidPosReceiptRequest(c *gin.Context)
+	HandleReadAllPosReceiptsRequest(c *gin.Context)
+}
+
+type posReceiptController struct {
+	service pb.PosReceiptServiceClient
+}
+
+func NewPosReceiptController(service pb.PosReceiptServiceClient) PosReceiptController {
+	return &posReceiptController{
+		service: service,
+	}
+}
+
+func (p *posReceiptController) HandleCreatePosReceiptRequest(ctx *gin.Context) {
+	var req pb.CreatePosReceiptRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_RECEIPT, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_RECEIPT, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := p.service.CreatePosReceipt(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_RECEIPT, err.Error(), nil)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_RECEIPT, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (p *posReceiptController) HandleReadPosReceiptRequest(ctx *gin.Context) {
+	posReceiptID := ctx.Param("id")
+
+	var req pb.ReadPosReceiptRequest
+	req.PosReceiptId = posReceiptID
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_RECEIPT, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := p.service.ReadPosReceipt(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_RECEIPT, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_RECEIPT, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (p *posReceiptController) HandleVoidPosReceiptRequest(ctx *gin.Context) {
+	var req pb.VoidPosReceiptRequest
+	posReceiptID := ctx.Param("id")
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_VOID_RECEIPT, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_VOID_RECEIPT, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+	req.PosReceiptId = posReceiptID
+	res, err := p.service.VoidPosReceipt(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_VOID_RECEIPT, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_VOID_RECEIPT, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (p *posReceiptController) HandleReadAllPosReceiptsRequest(ctx *gin.Context) {
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	var req pb.ReadAllPosReceiptsRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_RECEIPT, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+	res, err := p.service.ReadAllPosReceipts(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_RECEIPT, err.Error(), nil)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_RECEIPT, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosReportController interface {
+	HandleGetDailyStoreSummaryRequest(c *gin.Context)
+	HandleGetSalesTimeSeriesRequest(c *gin.Context)
+}
+
+type posReportController struct {
+	service pb.PosReportServiceClient
+}
+
+func NewPosReportController(service pb.PosReportServiceClient) PosReportController {
+	return &posReportController{
+		service: service,
+	}
+}
+
+func (p *posReportController) HandleGetDailyStoreSummaryRequest(ctx *gin.Context) {
+	var req pb.GetDailyStoreSummaryRequest
+	req.StoreId = ctx.Param("store_id")
+	// date is YYYY-MM-DD and time_zone an IANA name, the server defaults them to today in UTC
+	req.BusinessDate = ctx.Query("date")
+	req.TimeZone = ctx.Query("time_zone")
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_DAILY_STORE_SUMMARY, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := p.service.GetDailyStoreSummary(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_DAILY_STORE_SUMMARY, err.Error(), nil)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_DAILY_STORE_SUMMARY, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (p *posReportController) HandleGetSalesTimeSeriesRequest(ctx *gin.Context) {
+	req, err := utils.ParseSalesSeriesRequest(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_SALES_TIME_SERIES, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_SALES_TIME_SERIES, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := p.service.GetSalesTimeSeries(ctx, req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_SALES_TIME_SERIES, err.Error(), nil)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_SALES_TIME_SERIES, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
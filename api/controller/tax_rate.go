@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosTaxRateController interface {
+	HandleCreatePosTaxRateRequest(c *gin.Context)
+	HandleReadPosTaxRateRequest(c *gin.Context)
+	HandleUpdatePosTaxRateRequest(c *gin.Context)
+	HandleDeletePosTaxRateRequest(c *gin.Context)
+	HandleReadAllPosTaxRatesRequest(c *gin.Context)
+}
+
+type posTaxRateController struct {
+	service pb.PosTaxRateServiceClient
+}
+
+func NewPosTaxRateController(service pb.PosTaxRateServiceClient) PosTaxRateController {
+	return &posTaxRateController{
+		service: service,
+	}
+}
+
+func (c *posTaxRateController) HandleCreatePosTaxRateRequest(ctx *gin.Context) {
+	// Declare req body Pos Tax Rate
+	var req pb.CreatePosTaxRateRequest
+
+	// First, binding tax rate data
+	if err := ctx.ShouldBindJSON(&req.PosTaxRate); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_TAX_RATE, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_TAX_RATE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	// Service call
+	res, err := c.service.CreatePosTaxRate(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_TAX_RATE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Success response
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_TAX_RATE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posTaxRateController) HandleReadPosTaxRateRequest(ctx *gin.Context) {
+	var req pb.ReadPosTaxRateRequest
+
+	// Get tax rate ID from URL
+	taxRateID := ctx.Param("id")
+	req.TaxRateId = taxRateID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_TAX_RATE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := c.service.ReadPosTaxRate(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_TAX_RATE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_TAX_RATE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posTaxRateController) HandleUpdatePosTaxRateRequest(ctx *gin.Context) {
+	var req pb.UpdatePosTaxRateRequest
+	taxRateID := ctx.Param("id")
+	if err := ctx.ShouldBindJSON(&req.PosTaxRate); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_TAX_RATE, err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_TAX_RATE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+	req.PosTaxRate.TaxRateId = taxRateID
+	res, err := c.service.UpdatePosTaxRate(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_TAX_RATE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_TAX_RATE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posTaxRateController) HandleDeletePosTaxRateRequest(ctx *gin.Context) {
+	var req pb.DeletePosTaxRateRequest
+
+	// Get tax rate ID from URL
+	taxRateID := ctx.Param("id")
+	req.TaxRateId = taxRateID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_TAX_RATE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := c.service.DeletePosTaxRate(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_TAX_RATE, err.Error(), nil)
+		ctx.JSON(http.StatusInternalServerError, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_DELETE_TAX_RATE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
+
+func (c *posTaxRateController) HandleReadAllPosTaxRatesRequest(ctx *gin.Context) {
+	// A limit without a page reads the pages by token
+	paging, err := utils.ParsePaging(ctx.Request.URL.Query())
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed("Invalid paging", err.Error(), nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	var req pb.ReadAllPosTaxRatesRequest
+	req.Limit = paging.Limit
+	req.Page = paging.Page
+	req.PageToken = paging.PageToken
+	req.EstimateCount = paging.EstimateCount
+
+	getJwtPayload, exist := ctx.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_TAX_RATE, "Jwt Payload is Empty", nil)
+		ctx.JSON(http.StatusBadRequest, errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+
+	authHeader := ctx.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format", logging.REQUEST_ID_KEY: logging.RequestID(ctx)})
+		ctx.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := c.service.ReadAllPosTaxRates(ctx, &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_TAX_RATE, err.Error(), nil)
+		ctx.JSON(utils.HTTPStatus(err), errorResponse.WithRequestID(ctx))
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_TAX_RATE, res)
+	ctx.JSON(http.StatusOK, successResponse)
+}
@@ -0,0 +1,30 @@
+package midlleware
+
+import (
+	"net/http"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/idempotency"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// IdempotencyKeyMiddleware puts the Idempotency-Key header into the request context,
+// so the gRPC call made with it carries the key and a retried checkout is answered with the first result
+func IdempotencyKeyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(idempotency.IDEMPOTENCY_KEY_HEADER)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if err := idempotency.Validate(key); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), logging.REQUEST_ID_KEY: logging.RequestID(c)})
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(idempotency.WithKey(c.Request.Context(), key))
+		c.Next()
+	}
+}
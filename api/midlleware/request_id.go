@@ -0,0 +1,48 @@
+package midlleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDMiddleware gives every request an id, the one sent in the X-Request-ID header or a new one.
+// The id goes into the request context, so the gRPC calls made with it carry the id in their metadata,
+// it is returned in the X-Request-ID response header and every request is logged with it.
+func RequestIDMiddleware(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(logging.REQUEST_ID_HEADER)
+		if requestID == "" {
+			requestID = logging.NewRequestID()
+		}
+
+		ctx := logging.WithRequestID(c.Request.Context(), requestID)
+		c.Request = c.Request.WithContext(ctx)
+		c.Header(logging.REQUEST_ID_HEADER, requestID)
+
+		start := time.Now()
+		c.Next()
+
+		attrs := []any{
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.FullPath()),
+			slog.Int("status", c.Writer.Status()),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.String()))
+		}
+
+		switch {
+		case c.Writer.Status() >= 500:
+			logger.ErrorContext(ctx, "http request", attrs...)
+		case c.Writer.Status() >= 400:
+			logger.WarnContext(ctx, "http request", attrs...)
+		default:
+			logger.InfoContext(ctx, "http request", attrs...)
+		}
+	}
+}
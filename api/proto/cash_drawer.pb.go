@@ -31,9 +31,9 @@ type PosCashDrawer struct {
 	StoreId         string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 	EmployeeId      string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
 	ReceiptId       string                 `protobuf:"bytes,4,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
-	CashIn          float64                `protobuf:"fixed64,5,opt,name=cash_in,json=cashIn,proto3" json:"cash_in,omitempty"`
-	Amount          float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	CashOut         float64                `protobuf:"fixed64,7,opt,name=cash_out,json=cashOut,proto3" json:"cash_out,omitempty"`
+	CashIn          *Money                 `protobuf:"bytes,18,opt,name=cash_in,json=cashIn,proto3" json:"cash_in,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,19,opt,name=amount,proto3" json:"amount,omitempty"`
+	CashOut         *Money                 `protobuf:"bytes,20,opt,name=cash_out,json=cashOut,proto3" json:"cash_out,omitempty"`
 	TransactionTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=transaction_time,json=transactionTime,proto3" json:"transaction_time,omitempty"`
 	RoleId          string                 `protobuf:"bytes,9,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
 	BranchId        string                 `protobuf:"bytes,10,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
@@ -43,6 +43,7 @@ type PosCashDrawer struct {
 	CreatedBy       string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	UpdatedBy       string                 `protobuf:"bytes,16,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	SessionId       string                 `protobuf:"bytes,17,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
 func (x *PosCashDrawer) Reset() {
@@ -105,25 +106,25 @@ func (x *PosCashDrawer) GetReceiptId() string {
 	return ""
 }
 
-func (x *PosCashDrawer) GetCashIn() float64 {
+func (x *PosCashDrawer) GetCashIn() *Money {
 	if x != nil {
 		return x.CashIn
 	}
-	return 0
+	return nil
 }
 
-func (x *PosCashDrawer) GetAmount() float64 {
+func (x *PosCashDrawer) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *PosCashDrawer) GetCashOut() float64 {
+func (x *PosCashDrawer) GetCashOut() *Money {
 	if x != nil {
 		return x.CashOut
 	}
-	return 0
+	return nil
 }
 
 func (x *PosCashDrawer) GetTransactionTime() *timestamppb.Timestamp {
@@ -189,6 +190,262 @@ func (x *PosCashDrawer) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *PosCashDrawer) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// PosDenominationCount is the number of notes or coins of one denomination counted at close
+type PosDenominationCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denomination *Money `protobuf:"bytes,4,opt,name=denomination,proto3" json:"denomination,omitempty"`
+	Count        int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Amount       *Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PosDenominationCount) Reset() {
+	*x = PosDenominationCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosDenominationCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosDenominationCount) ProtoMessage() {}
+
+func (x *PosDenominationCount) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosDenominationCount.ProtoReflect.Descriptor instead.
+func (*PosDenominationCount) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PosDenominationCount) GetDenomination() *Money {
+	if x != nil {
+		return x.Denomination
+	}
+	return nil
+}
+
+func (x *PosDenominationCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PosDenominationCount) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// PosDrawerSession is one cashier shift on a cash drawer.
+// expected_cash is the opening float plus the cash ledger of the session, variance is counted minus expected.
+type PosDrawerSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StoreId       string                  `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	BranchId      string                  `protobuf:"bytes,3,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	CompanyId     string                  `protobuf:"bytes,4,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CashierId     string                  `protobuf:"bytes,5,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
+	Status        string                  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	OpeningFloat  *Money                  `protobuf:"bytes,19,opt,name=opening_float,json=openingFloat,proto3" json:"opening_float,omitempty"`
+	ExpectedCash  *Money                  `protobuf:"bytes,20,opt,name=expected_cash,json=expectedCash,proto3" json:"expected_cash,omitempty"`
+	CountedCash   *Money                  `protobuf:"bytes,21,opt,name=counted_cash,json=countedCash,proto3" json:"counted_cash,omitempty"`
+	Variance      *Money                  `protobuf:"bytes,22,opt,name=variance,proto3" json:"variance,omitempty"`
+	Denominations []*PosDenominationCount `protobuf:"bytes,11,rep,name=denominations,proto3" json:"denominations,omitempty"`
+	OpenedAt      *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedAt      *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	ClosedBy      string                  `protobuf:"bytes,14,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                  `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     string                  `protobuf:"bytes,18,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (x *PosDrawerSession) Reset() {
+	*x = PosDrawerSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosDrawerSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosDrawerSession) ProtoMessage() {}
+
+func (x *PosDrawerSession) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosDrawerSession.ProtoReflect.Descriptor instead.
+func (*PosDrawerSession) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PosDrawerSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetCashierId() string {
+	if x != nil {
+		return x.CashierId
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetOpeningFloat() *Money {
+	if x != nil {
+		return x.OpeningFloat
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetExpectedCash() *Money {
+	if x != nil {
+		return x.ExpectedCash
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetCountedCash() *Money {
+	if x != nil {
+		return x.CountedCash
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetVariance() *Money {
+	if x != nil {
+		return x.Variance
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetDenominations() []*PosDenominationCount {
+	if x != nil {
+		return x.Denominations
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PosDrawerSession) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PosDrawerSession) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
 // Request and Response messages
 type CreatePosCashDrawerRequest struct {
 	state         protoimpl.MessageState
@@ -203,7 +460,7 @@ type CreatePosCashDrawerRequest struct {
 func (x *CreatePosCashDrawerRequest) Reset() {
 	*x = CreatePosCashDrawerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[1]
+		mi := &file_cash_drawer_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -216,7 +473,7 @@ func (x *CreatePosCashDrawerRequest) String() string {
 func (*CreatePosCashDrawerRequest) ProtoMessage() {}
 
 func (x *CreatePosCashDrawerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[1]
+	mi := &file_cash_drawer_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -229,7 +486,7 @@ func (x *CreatePosCashDrawerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePosCashDrawerRequest.ProtoReflect.Descriptor instead.
 func (*CreatePosCashDrawerRequest) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{1}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePosCashDrawerRequest) GetPosCashDrawer() *PosCashDrawer {
@@ -264,7 +521,7 @@ type CreatePosCashDrawerResponse struct {
 func (x *CreatePosCashDrawerResponse) Reset() {
 	*x = CreatePosCashDrawerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[2]
+		mi := &file_cash_drawer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -277,7 +534,7 @@ func (x *CreatePosCashDrawerResponse) String() string {
 func (*CreatePosCashDrawerResponse) ProtoMessage() {}
 
 func (x *CreatePosCashDrawerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[2]
+	mi := &file_cash_drawer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +547,7 @@ func (x *CreatePosCashDrawerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePosCashDrawerResponse.ProtoReflect.Descriptor instead.
 func (*CreatePosCashDrawerResponse) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{2}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreatePosCashDrawerResponse) GetPosCashDrawer() *PosCashDrawer {
@@ -313,7 +570,7 @@ type ReadPosCashDrawerRequest struct {
 func (x *ReadPosCashDrawerRequest) Reset() {
 	*x = ReadPosCashDrawerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[3]
+		mi := &file_cash_drawer_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -326,7 +583,7 @@ func (x *ReadPosCashDrawerRequest) String() string {
 func (*ReadPosCashDrawerRequest) ProtoMessage() {}
 
 func (x *ReadPosCashDrawerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[3]
+	mi := &file_cash_drawer_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +596,7 @@ func (x *ReadPosCashDrawerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadPosCashDrawerRequest.ProtoReflect.Descriptor instead.
 func (*ReadPosCashDrawerRequest) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{3}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReadPosCashDrawerRequest) GetDrawerId() string {
@@ -374,7 +631,7 @@ type ReadPosCashDrawerResponse struct {
 func (x *ReadPosCashDrawerResponse) Reset() {
 	*x = ReadPosCashDrawerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[4]
+		mi := &file_cash_drawer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -387,7 +644,7 @@ func (x *ReadPosCashDrawerResponse) String() string {
 func (*ReadPosCashDrawerResponse) ProtoMessage() {}
 
 func (x *ReadPosCashDrawerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[4]
+	mi := &file_cash_drawer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +657,7 @@ func (x *ReadPosCashDrawerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadPosCashDrawerResponse.ProtoReflect.Descriptor instead.
 func (*ReadPosCashDrawerResponse) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{4}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReadPosCashDrawerResponse) GetPosCashDrawer() *PosCashDrawer {
@@ -423,7 +680,7 @@ type UpdatePosCashDrawerRequest struct {
 func (x *UpdatePosCashDrawerRequest) Reset() {
 	*x = UpdatePosCashDrawerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[5]
+		mi := &file_cash_drawer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -436,7 +693,7 @@ func (x *UpdatePosCashDrawerRequest) String() string {
 func (*UpdatePosCashDrawerRequest) ProtoMessage() {}
 
 func (x *UpdatePosCashDrawerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[5]
+	mi := &file_cash_drawer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +706,7 @@ func (x *UpdatePosCashDrawerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePosCashDrawerRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePosCashDrawerRequest) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{5}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdatePosCashDrawerRequest) GetPosCashDrawer() *PosCashDrawer {
@@ -484,7 +741,7 @@ type UpdatePosCashDrawerResponse struct {
 func (x *UpdatePosCashDrawerResponse) Reset() {
 	*x = UpdatePosCashDrawerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[6]
+		mi := &file_cash_drawer_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -497,7 +754,7 @@ func (x *UpdatePosCashDrawerResponse) String() string {
 func (*UpdatePosCashDrawerResponse) ProtoMessage() {}
 
 func (x *UpdatePosCashDrawerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[6]
+	mi := &file_cash_drawer_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +767,7 @@ func (x *UpdatePosCashDrawerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePosCashDrawerResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePosCashDrawerResponse) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{6}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdatePosCashDrawerResponse) GetPosCashDrawer() *PosCashDrawer {
@@ -533,7 +790,7 @@ type DeletePosCashDrawerRequest struct {
 func (x *DeletePosCashDrawerRequest) Reset() {
 	*x = DeletePosCashDrawerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[7]
+		mi := &file_cash_drawer_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -546,7 +803,7 @@ func (x *DeletePosCashDrawerRequest) String() string {
 func (*DeletePosCashDrawerRequest) ProtoMessage() {}
 
 func (x *DeletePosCashDrawerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[7]
+	mi := &file_cash_drawer_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +816,7 @@ func (x *DeletePosCashDrawerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePosCashDrawerRequest.ProtoReflect.Descriptor instead.
 func (*DeletePosCashDrawerRequest) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{7}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeletePosCashDrawerRequest) GetDrawerId() string {
@@ -594,7 +851,7 @@ type DeletePosCashDrawerResponse struct {
 func (x *DeletePosCashDrawerResponse) Reset() {
 	*x = DeletePosCashDrawerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[8]
+		mi := &file_cash_drawer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -607,7 +864,7 @@ func (x *DeletePosCashDrawerResponse) String() string {
 func (*DeletePosCashDrawerResponse) ProtoMessage() {}
 
 func (x *DeletePosCashDrawerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[8]
+	mi := &file_cash_drawer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +877,7 @@ func (x *DeletePosCashDrawerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePosCashDrawerResponse.ProtoReflect.Descriptor instead.
 func (*DeletePosCashDrawerResponse) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{8}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeletePosCashDrawerResponse) GetSuccess() bool {
@@ -635,16 +892,19 @@ type ReadAllPosCashDrawersRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit      int32       `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page       int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	JwtPayload *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
-	JwtToken   string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	Limit         int32       `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	JwtPayload    *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken      string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	Query         *ListQuery  `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	PageToken     string      `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`              // next_page_token of the previous page, used when limit is set without a page
+	EstimateCount bool        `protobuf:"varint,7,opt,name=estimate_count,json=estimateCount,proto3" json:"estimate_count,omitempty"` // count from the query planner instead of counting every record
 }
 
 func (x *ReadAllPosCashDrawersRequest) Reset() {
 	*x = ReadAllPosCashDrawersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[9]
+		mi := &file_cash_drawer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +917,7 @@ func (x *ReadAllPosCashDrawersRequest) String() string {
 func (*ReadAllPosCashDrawersRequest) ProtoMessage() {}
 
 func (x *ReadAllPosCashDrawersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[9]
+	mi := &file_cash_drawer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +930,7 @@ func (x *ReadAllPosCashDrawersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAllPosCashDrawersRequest.ProtoReflect.Descriptor instead.
 func (*ReadAllPosCashDrawersRequest) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{9}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReadAllPosCashDrawersRequest) GetLimit() int32 {
@@ -701,6 +961,27 @@ func (x *ReadAllPosCashDrawersRequest) GetJwtToken() string {
 	return ""
 }
 
+func (x *ReadAllPosCashDrawersRequest) GetQuery() *ListQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ReadAllPosCashDrawersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadAllPosCashDrawersRequest) GetEstimateCount() bool {
+	if x != nil {
+		return x.EstimateCount
+	}
+	return false
+}
+
 type ReadAllPosCashDrawersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -711,12 +992,14 @@ type ReadAllPosCashDrawersResponse struct {
 	Page           int32            `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	MaxPage        int32            `protobuf:"varint,4,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
 	Count          int64            `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	NextPageToken  string           `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	CountEstimated bool             `protobuf:"varint,7,opt,name=count_estimated,json=countEstimated,proto3" json:"count_estimated,omitempty"`
 }
 
 func (x *ReadAllPosCashDrawersResponse) Reset() {
 	*x = ReadAllPosCashDrawersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cash_drawer_proto_msgTypes[10]
+		mi := &file_cash_drawer_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -729,7 +1012,7 @@ func (x *ReadAllPosCashDrawersResponse) String() string {
 func (*ReadAllPosCashDrawersResponse) ProtoMessage() {}
 
 func (x *ReadAllPosCashDrawersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cash_drawer_proto_msgTypes[10]
+	mi := &file_cash_drawer_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +1025,7 @@ func (x *ReadAllPosCashDrawersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAllPosCashDrawersResponse.ProtoReflect.Descriptor instead.
 func (*ReadAllPosCashDrawersResponse) Descriptor() ([]byte, []int) {
-	return file_cash_drawer_proto_rawDescGZIP(), []int{10}
+	return file_cash_drawer_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReadAllPosCashDrawersResponse) GetPosCashDrawers() []*PosCashDrawer {
@@ -780,164 +1063,748 @@ func (x *ReadAllPosCashDrawersResponse) GetCount() int64 {
 	return 0
 }
 
-var File_cash_drawer_proto protoreflect.FileDescriptor
-
+func (x *ReadAllPosCashDrawersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadAllPosCashDrawersResponse) GetCountEstimated() bool {
+	if x != nil {
+		return x.CountEstimated
+	}
+	return false
+}
+
+type OpenDrawerSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpeningFloat *Money      `protobuf:"bytes,4,opt,name=opening_float,json=openingFloat,proto3" json:"opening_float,omitempty"`
+	JwtPayload   *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken     string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *OpenDrawerSessionRequest) Reset() {
+	*x = OpenDrawerSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenDrawerSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDrawerSessionRequest) ProtoMessage() {}
+
+func (x *OpenDrawerSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDrawerSessionRequest.ProtoReflect.Descriptor instead.
+func (*OpenDrawerSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OpenDrawerSessionRequest) GetOpeningFloat() *Money {
+	if x != nil {
+		return x.OpeningFloat
+	}
+	return nil
+}
+
+func (x *OpenDrawerSessionRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *OpenDrawerSessionRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type OpenDrawerSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosDrawerSession *PosDrawerSession `protobuf:"bytes,1,opt,name=pos_drawer_session,json=posDrawerSession,proto3" json:"pos_drawer_session,omitempty"`
+}
+
+func (x *OpenDrawerSessionResponse) Reset() {
+	*x = OpenDrawerSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenDrawerSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDrawerSessionResponse) ProtoMessage() {}
+
+func (x *OpenDrawerSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDrawerSessionResponse.ProtoReflect.Descriptor instead.
+func (*OpenDrawerSessionResponse) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OpenDrawerSessionResponse) GetPosDrawerSession() *PosDrawerSession {
+	if x != nil {
+		return x.PosDrawerSession
+	}
+	return nil
+}
+
+type CloseDrawerSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Denominations []*PosDenominationCount `protobuf:"bytes,2,rep,name=denominations,proto3" json:"denominations,omitempty"`
+	JwtPayload    *JWTPayload             `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken      string                  `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *CloseDrawerSessionRequest) Reset() {
+	*x = CloseDrawerSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseDrawerSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseDrawerSessionRequest) ProtoMessage() {}
+
+func (x *CloseDrawerSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseDrawerSessionRequest.ProtoReflect.Descriptor instead.
+func (*CloseDrawerSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CloseDrawerSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CloseDrawerSessionRequest) GetDenominations() []*PosDenominationCount {
+	if x != nil {
+		return x.Denominations
+	}
+	return nil
+}
+
+func (x *CloseDrawerSessionRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *CloseDrawerSessionRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type CloseDrawerSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosDrawerSession *PosDrawerSession `protobuf:"bytes,1,opt,name=pos_drawer_session,json=posDrawerSession,proto3" json:"pos_drawer_session,omitempty"`
+}
+
+func (x *CloseDrawerSessionResponse) Reset() {
+	*x = CloseDrawerSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseDrawerSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseDrawerSessionResponse) ProtoMessage() {}
+
+func (x *CloseDrawerSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseDrawerSessionResponse.ProtoReflect.Descriptor instead.
+func (*CloseDrawerSessionResponse) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CloseDrawerSessionResponse) GetPosDrawerSession() *PosDrawerSession {
+	if x != nil {
+		return x.PosDrawerSession
+	}
+	return nil
+}
+
+type ReadAllDrawerSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit         int32       `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	JwtPayload    *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken      string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	PageToken     string      `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`              // next_page_token of the previous page, used when limit is set without a page
+	EstimateCount bool        `protobuf:"varint,7,opt,name=estimate_count,json=estimateCount,proto3" json:"estimate_count,omitempty"` // count from the query planner instead of counting every record
+}
+
+func (x *ReadAllDrawerSessionsRequest) Reset() {
+	*x = ReadAllDrawerSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAllDrawerSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllDrawerSessionsRequest) ProtoMessage() {}
+
+func (x *ReadAllDrawerSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllDrawerSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllDrawerSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReadAllDrawerSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadAllDrawerSessionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadAllDrawerSessionsRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReadAllDrawerSessionsRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+func (x *ReadAllDrawerSessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadAllDrawerSessionsRequest) GetEstimateCount() bool {
+	if x != nil {
+		return x.EstimateCount
+	}
+	return false
+}
+
+type ReadAllDrawerSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosDrawerSessions []*PosDrawerSession `protobuf:"bytes,1,rep,name=pos_drawer_sessions,json=posDrawerSessions,proto3" json:"pos_drawer_sessions,omitempty"`
+	Limit             int32               `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page              int32               `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	MaxPage           int32               `protobuf:"varint,4,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
+	Count             int64               `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	NextPageToken     string              `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	CountEstimated    bool                `protobuf:"varint,7,opt,name=count_estimated,json=countEstimated,proto3" json:"count_estimated,omitempty"`
+}
+
+func (x *ReadAllDrawerSessionsResponse) Reset() {
+	*x = ReadAllDrawerSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_drawer_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAllDrawerSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllDrawerSessionsResponse) ProtoMessage() {}
+
+func (x *ReadAllDrawerSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_drawer_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllDrawerSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllDrawerSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_cash_drawer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReadAllDrawerSessionsResponse) GetPosDrawerSessions() []*PosDrawerSession {
+	if x != nil {
+		return x.PosDrawerSessions
+	}
+	return nil
+}
+
+func (x *ReadAllDrawerSessionsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadAllDrawerSessionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadAllDrawerSessionsResponse) GetMaxPage() int32 {
+	if x != nil {
+		return x.MaxPage
+	}
+	return 0
+}
+
+func (x *ReadAllDrawerSessionsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReadAllDrawerSessionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadAllDrawerSessionsResponse) GetCountEstimated() bool {
+	if x != nil {
+		return x.CountEstimated
+	}
+	return false
+}
+
+var File_cash_drawer_proto protoreflect.FileDescriptor
+
 var file_cash_drawer_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70, 0x6f, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x04, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x43,
-	0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x61,
-	0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72,
-	0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x63, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x45, 0x0a,
-	0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x05, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
+	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x61, 0x77, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x07, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x63, 0x61, 0x73,
+	0x68, 0x49, 0x6e, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x63, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x45,
+	0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10,
+	0x08, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0c, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x22, 0x94, 0x06, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x61, 0x73, 0x68, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x61, 0x73, 0x68, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2f, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x61, 0x73, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x61, 0x73, 0x68, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x43,
+	0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x62, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22,
-	0xa7, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73,
-	0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
-	0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
-	0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f, 0x73,
-	0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77,
-	0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x1b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f,
-	0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44,
-	0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72,
-	0x61, 0x77, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73,
-	0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30,
-	0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a,
-	0x19, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x6f,
-	0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73,
-	0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
-	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73,
-	0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
-	0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65,
-	0x72, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x59, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73,
-	0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3a, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50,
-	0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f,
-	0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x1a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61,
-	0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72,
-	0x61, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x4a,
+	0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x09, 0x10,
+	0x0a, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61,
+	0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
+	0x65, 0x72, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57,
+	0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x59, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61,
+	0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0d, 0x70,
+	0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a,
+	0x18, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72,
+	0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77,
+	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73,
+	0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52,
+	0x0d, 0x70, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x22, 0xa7,
+	0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
+	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x43,
+	0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x63,
+	0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72,
+	0x61, 0x77, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x37,
+	0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57,
+	0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x24, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x89, 0x02,
+	0x0a, 0x1d, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
+	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0e, 0x70,
+	0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x50, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x4f, 0x70,
+	0x65, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70,
 	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
 	0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a,
 	0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74,
 	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x37, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0x97, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61,
-	0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77,
-	0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x1d, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x70,
-	0x6f, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43,
-	0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x43, 0x61,
-	0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x32, 0xd8, 0x03, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
-	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72,
-	0x61, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x60, 0x0a, 0x19,
+	0x4f, 0x70, 0x65, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x6f, 0x73,
+	0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x44,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x70, 0x6f,
+	0x73, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xca,
+	0x01, 0x0a, 0x19, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b,
+	0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x61, 0x0a, 0x1a, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x6f, 0x73,
+	0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x44,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x70, 0x6f,
+	0x73, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdd,
+	0x01, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92,
+	0x02, 0x0a, 0x1d, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x32, 0xe3, 0x05, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x50,
-	0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70,
-	0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72,
-	0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f,
+	0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x6f,
 	0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61,
-	0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
-	0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65,
+	0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
 	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
-	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73,
-	0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5e, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73,
-	0x68, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61,
-	0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f,
-	0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
-	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e,
-	0x64, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d,
-	0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2d, 0x73, 0x61, 0x6c, 0x65, 0x73,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68,
+	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44, 0x72, 0x61, 0x77,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x43, 0x61, 0x73, 0x68, 0x44,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x72,
+	0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x61, 0x77, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x15, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6c, 0x6c, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c,
+	0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6c, 0x6c, 0x44, 0x72, 0x61, 0x77, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2d, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -952,52 +1819,91 @@ func file_cash_drawer_proto_rawDescGZIP() []byte {
 	return file_cash_drawer_proto_rawDescData
 }
 
-var file_cash_drawer_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cash_drawer_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_cash_drawer_proto_goTypes = []interface{}{
 	(*PosCashDrawer)(nil),                 // 0: pos.PosCashDrawer
-	(*CreatePosCashDrawerRequest)(nil),    // 1: pos.CreatePosCashDrawerRequest
-	(*CreatePosCashDrawerResponse)(nil),   // 2: pos.CreatePosCashDrawerResponse
-	(*ReadPosCashDrawerRequest)(nil),      // 3: pos.ReadPosCashDrawerRequest
-	(*ReadPosCashDrawerResponse)(nil),     // 4: pos.ReadPosCashDrawerResponse
-	(*UpdatePosCashDrawerRequest)(nil),    // 5: pos.UpdatePosCashDrawerRequest
-	(*UpdatePosCashDrawerResponse)(nil),   // 6: pos.UpdatePosCashDrawerResponse
-	(*DeletePosCashDrawerRequest)(nil),    // 7: pos.DeletePosCashDrawerRequest
-	(*DeletePosCashDrawerResponse)(nil),   // 8: pos.DeletePosCashDrawerResponse
-	(*ReadAllPosCashDrawersRequest)(nil),  // 9: pos.ReadAllPosCashDrawersRequest
-	(*ReadAllPosCashDrawersResponse)(nil), // 10: pos.ReadAllPosCashDrawersResponse
-	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
-	(*JWTPayload)(nil),                    // 12: pos.JWTPayload
+	(*PosDenominationCount)(nil),          // 1: pos.PosDenominationCount
+	(*PosDrawerSession)(nil),              // 2: pos.PosDrawerSession
+	(*CreatePosCashDrawerRequest)(nil),    // 3: pos.CreatePosCashDrawerRequest
+	(*CreatePosCashDrawerResponse)(nil),   // 4: pos.CreatePosCashDrawerResponse
+	(*ReadPosCashDrawerRequest)(nil),      // 5: pos.ReadPosCashDrawerRequest
+	(*ReadPosCashDrawerResponse)(nil),     // 6: pos.ReadPosCashDrawerResponse
+	(*UpdatePosCashDrawerRequest)(nil),    // 7: pos.UpdatePosCashDrawerRequest
+	(*UpdatePosCashDrawerResponse)(nil),   // 8: pos.UpdatePosCashDrawerResponse
+	(*DeletePosCashDrawerRequest)(nil),    // 9: pos.DeletePosCashDrawerRequest
+	(*DeletePosCashDrawerResponse)(nil),   // 10: pos.DeletePosCashDrawerResponse
+	(*ReadAllPosCashDrawersRequest)(nil),  // 11: pos.ReadAllPosCashDrawersRequest
+	(*ReadAllPosCashDrawersResponse)(nil), // 12: pos.ReadAllPosCashDrawersResponse
+	(*OpenDrawerSessionRequest)(nil),      // 13: pos.OpenDrawerSessionRequest
+	(*OpenDrawerSessionResponse)(nil),     // 14: pos.OpenDrawerSessionResponse
+	(*CloseDrawerSessionRequest)(nil),     // 15: pos.CloseDrawerSessionRequest
+	(*CloseDrawerSessionResponse)(nil),    // 16: pos.CloseDrawerSessionResponse
+	(*ReadAllDrawerSessionsRequest)(nil),  // 17: pos.ReadAllDrawerSessionsRequest
+	(*ReadAllDrawerSessionsResponse)(nil), // 18: pos.ReadAllDrawerSessionsResponse
+	(*Money)(nil),                         // 19: pos.Money
+	(*timestamppb.Timestamp)(nil),         // 20: google.protobuf.Timestamp
+	(*JWTPayload)(nil),                    // 21: pos.JWTPayload
+	(*ListQuery)(nil),                     // 22: pos.ListQuery
 }
 var file_cash_drawer_proto_depIdxs = []int32{
-	11, // 0: pos.PosCashDrawer.transaction_time:type_name -> google.protobuf.Timestamp
-	11, // 1: pos.PosCashDrawer.created_at:type_name -> google.protobuf.Timestamp
-	11, // 2: pos.PosCashDrawer.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: pos.CreatePosCashDrawerRequest.pos_cash_drawer:type_name -> pos.PosCashDrawer
-	12, // 4: pos.CreatePosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 5: pos.CreatePosCashDrawerResponse.pos_cash_drawer:type_name -> pos.PosCashDrawer
-	12, // 6: pos.ReadPosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 7: pos.ReadPosCashDrawerResponse.pos_cash_drawer:type_name -> pos.PosCashDrawer
-	0,  // 8: pos.UpdatePosCashDrawerRequest.pos_cash_drawer:type_name -> pos.PosCashDrawer
-	12, // 9: pos.UpdatePosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 10: pos.UpdatePosCashDrawerResponse.pos_cash_drawer:type_name -> pos.PosCashDrawer
-	12, // 11: pos.DeletePosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
-	12, // 12: pos.ReadAllPosCashDrawersRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 13: pos.ReadAllPosCashDrawersResponse.pos_cash_drawers:type_name -> pos.PosCashDrawer
-	1,  // 14: pos.PosCashDrawerService.CreatePosCashDrawer:input_type -> pos.CreatePosCashDrawerRequest
-	3,  // 15: pos.PosCashDrawerService.ReadPosCashDrawer:input_type -> pos.ReadPosCashDrawerRequest
-	5,  // 16: pos.PosCashDrawerService.UpdatePosCashDrawer:input_type -> pos.UpdatePosCashDrawerRequest
-	7,  // 17: pos.PosCashDrawerService.DeletePosCashDrawer:input_type -> pos.DeletePosCashDrawerRequest
-	9,  // 18: pos.PosCashDrawerService.ReadAllPosCashDrawers:input_type -> pos.ReadAllPosCashDrawersRequest
-	2,  // 19: pos.PosCashDrawerService.CreatePosCashDrawer:output_type -> pos.CreatePosCashDrawerResponse
-	4,  // 20: pos.PosCashDrawerService.ReadPosCashDrawer:output_type -> pos.ReadPosCashDrawerResponse
-	6,  // 21: pos.PosCashDrawerService.UpdatePosCashDrawer:output_type -> pos.UpdatePosCashDrawerResponse
-	8,  // 22: pos.PosCashDrawerService.DeletePosCashDrawer:output_type -> pos.DeletePosCashDrawerResponse
-	10, // 23: pos.PosCashDrawerService.ReadAllPosCashDrawers:output_type -> pos.ReadAllPosCashDrawersResponse
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	19, // 0: pos.PosCashDrawer.cash_in:type_name -> pos.Money
+	19, // 1: pos.PosCashDrawer.amount:type_name -> pos.Money
+	19, // 2: pos.PosCashDrawer.cash_out:type_name -> pos.Money
+	20, // 3: pos.PosCashDrawer.transaction_time:type_name -> google.protobuf.Timestamp
+	20, // 4: pos.PosCashDrawer.created_at:type_name -> google.protobuf.Timestamp
+	20, // 5: pos.PosCashDrawer.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 6: pos.PosDenominationCount.denomination:type_name -> pos.Money
+	19, // 7: pos.PosDenominationCount.amount:type_name -> pos.Money
+	19, // 8: pos.PosDrawerSession.opening_float:type_name -> pos.Money
+	19, // 9: pos.PosDrawerSession.expected_cash:type_name -> pos.Money
+	19, // 10: pos.PosDrawerSession.counted_cash:type_name -> pos.Money
+	19, // 11: pos.PosDrawerSession.variance:type_name -> pos.Money
+	1,  // 12: pos.PosDrawerSession.denominations:type_name -> pos.PosDenominationCount
+	20, // 13: pos.PosDrawerSession.opened_at:type_name -> google.protobuf.Timestamp
+	20, // 14: pos.PosDrawerSession.closed_at:type_name -> google.protobuf.Timestamp
+	20, // 15: pos.PosDrawerSession.created_at:type_name -> google.protobuf.Timestamp
+	20, // 16: pos.PosDrawerSession.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: pos.CreatePosCashDrawerRequest.pos_cash_drawer:type_name -> pos.PosCashDrawer
+	21, // 18: pos.CreatePosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 19: pos.CreatePosCashDrawerResponse.pos_cash_drawer:type_name -> pos.PosCashDrawer
+	21, // 20: pos.ReadPosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 21: pos.ReadPosCashDrawerResponse.pos_cash_drawer:type_name -> pos.PosCashDrawer
+	0,  // 22: pos.UpdatePosCashDrawerRequest.pos_cash_drawer:type_name -> pos.PosCashDrawer
+	21, // 23: pos.UpdatePosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 24: pos.UpdatePosCashDrawerResponse.pos_cash_drawer:type_name -> pos.PosCashDrawer
+	21, // 25: pos.DeletePosCashDrawerRequest.jwt_payload:type_name -> pos.JWTPayload
+	21, // 26: pos.ReadAllPosCashDrawersRequest.jwt_payload:type_name -> pos.JWTPayload
+	22, // 27: pos.ReadAllPosCashDrawersRequest.query:type_name -> pos.ListQuery
+	0,  // 28: pos.ReadAllPosCashDrawersResponse.pos_cash_drawers:type_name -> pos.PosCashDrawer
+	19, // 29: pos.OpenDrawerSessionRequest.opening_float:type_name -> pos.Money
+	21, // 30: pos.OpenDrawerSessionRequest.jwt_payload:type_name -> pos.JWTPayload
+	2,  // 31: pos.OpenDrawerSessionResponse.pos_drawer_session:type_name -> pos.PosDrawerSession
+	1,  // 32: pos.CloseDrawerSessionRequest.denominations:type_name -> pos.PosDenominationCount
+	21, // 33: pos.CloseDrawerSessionRequest.jwt_payload:type_name -> pos.JWTPayload
+	2,  // 34: pos.CloseDrawerSessionResponse.pos_drawer_session:type_name -> pos.PosDrawerSession
+	21, // 35: pos.ReadAllDrawerSessionsRequest.jwt_payload:type_name -> pos.JWTPayload
+	2,  // 36: pos.ReadAllDrawerSessionsResponse.pos_drawer_sessions:type_name -> pos.PosDrawerSession
+	3,  // 37: pos.PosCashDrawerService.CreatePosCashDrawer:input_type -> pos.CreatePosCashDrawerRequest
+	5,  // 38: pos.PosCashDrawerService.ReadPosCashDrawer:input_type -> pos.ReadPosCashDrawerRequest
+	7,  // 39: pos.PosCashDrawerService.UpdatePosCashDrawer:input_type -> pos.UpdatePosCashDrawerRequest
+	9,  // 40: pos.PosCashDrawerService.DeletePosCashDrawer:input_type -> pos.DeletePosCashDrawerRequest
+	11, // 41: pos.PosCashDrawerService.ReadAllPosCashDrawers:input_type -> pos.ReadAllPosCashDrawersRequest
+	13, // 42: pos.PosCashDrawerService.OpenDrawerSession:input_type -> pos.OpenDrawerSessionRequest
+	15, // 43: pos.PosCashDrawerService.CloseDrawerSession:input_type -> pos.CloseDrawerSessionRequest
+	17, // 44: pos.PosCashDrawerService.ReadAllDrawerSessions:input_type -> pos.ReadAllDrawerSessionsRequest
+	4,  // 45: pos.PosCashDrawerService.CreatePosCashDrawer:output_type -> pos.CreatePosCashDrawerResponse
+	6,  // 46: pos.PosCashDrawerService.ReadPosCashDrawer:output_type -> pos.ReadPosCashDrawerResponse
+	8,  // 47: pos.PosCashDrawerService.UpdatePosCashDrawer:output_type -> pos.UpdatePosCashDrawerResponse
+	10, // 48: pos.PosCashDrawerService.DeletePosCashDrawer:output_type -> pos.DeletePosCashDrawerResponse
+	12, // 49: pos.PosCashDrawerService.ReadAllPosCashDrawers:output_type -> pos.ReadAllPosCashDrawersResponse
+	14, // 50: pos.PosCashDrawerService.OpenDrawerSession:output_type -> pos.OpenDrawerSessionResponse
+	16, // 51: pos.PosCashDrawerService.CloseDrawerSession:output_type -> pos.CloseDrawerSessionResponse
+	18, // 52: pos.PosCashDrawerService.ReadAllDrawerSessions:output_type -> pos.ReadAllDrawerSessionsResponse
+	45, // [45:53] is the sub-list for method output_type
+	37, // [37:45] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_cash_drawer_proto_init() }
@@ -1006,6 +1912,7 @@ func file_cash_drawer_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_money_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_cash_drawer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PosCashDrawer); i {
@@ -1020,7 +1927,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePosCashDrawerRequest); i {
+			switch v := v.(*PosDenominationCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1032,7 +1939,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePosCashDrawerResponse); i {
+			switch v := v.(*PosDrawerSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1044,7 +1951,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPosCashDrawerRequest); i {
+			switch v := v.(*CreatePosCashDrawerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1056,7 +1963,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPosCashDrawerResponse); i {
+			switch v := v.(*CreatePosCashDrawerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1068,7 +1975,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePosCashDrawerRequest); i {
+			switch v := v.(*ReadPosCashDrawerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1080,7 +1987,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePosCashDrawerResponse); i {
+			switch v := v.(*ReadPosCashDrawerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1092,7 +1999,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePosCashDrawerRequest); i {
+			switch v := v.(*UpdatePosCashDrawerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1104,7 +2011,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePosCashDrawerResponse); i {
+			switch v := v.(*UpdatePosCashDrawerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1116,7 +2023,7 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAllPosCashDrawersRequest); i {
+			switch v := v.(*DeletePosCashDrawerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1128,6 +2035,30 @@ func file_cash_drawer_proto_init() {
 			}
 		}
 		file_cash_drawer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePosCashDrawerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_drawer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllPosCashDrawersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_drawer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadAllPosCashDrawersResponse); i {
 			case 0:
 				return &v.state
@@ -1139,6 +2070,78 @@ func file_cash_drawer_proto_init() {
 				return nil
 			}
 		}
+		file_cash_drawer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenDrawerSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_drawer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenDrawerSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_drawer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseDrawerSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_drawer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseDrawerSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_drawer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllDrawerSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_drawer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllDrawerSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1146,7 +2149,7 @@ func file_cash_drawer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cash_drawer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
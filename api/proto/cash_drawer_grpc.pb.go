@@ -27,6 +27,9 @@ type PosCashDrawerServiceClient interface {
 	UpdatePosCashDrawer(ctx context.Context, in *UpdatePosCashDrawerRequest, opts ...grpc.CallOption) (*UpdatePosCashDrawerResponse, error)
 	DeletePosCashDrawer(ctx context.Context, in *DeletePosCashDrawerRequest, opts ...grpc.CallOption) (*DeletePosCashDrawerResponse, error)
 	ReadAllPosCashDrawers(ctx context.Context, in *ReadAllPosCashDrawersRequest, opts ...grpc.CallOption) (*ReadAllPosCashDrawersResponse, error)
+	OpenDrawerSession(ctx context.Context, in *OpenDrawerSessionRequest, opts ...grpc.CallOption) (*OpenDrawerSessionResponse, error)
+	CloseDrawerSession(ctx context.Context, in *CloseDrawerSessionRequest, opts ...grpc.CallOption) (*CloseDrawerSessionResponse, error)
+	ReadAllDrawerSessions(ctx context.Context, in *ReadAllDrawerSessionsRequest, opts ...grpc.CallOption) (*ReadAllDrawerSessionsResponse, error)
 }
 
 type posCashDrawerServiceClient struct {
@@ -82,6 +85,33 @@ func (c *posCashDrawerServiceClient) ReadAllPosCashDrawers(ctx context.Context,
 	return out, nil
 }
 
+func (c *posCashDrawerServiceClient) OpenDrawerSession(ctx context.Context, in *OpenDrawerSessionRequest, opts ...grpc.CallOption) (*OpenDrawerSessionResponse, error) {
+	out := new(OpenDrawerSessionResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosCashDrawerService/OpenDrawerSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posCashDrawerServiceClient) CloseDrawerSession(ctx context.Context, in *CloseDrawerSessionRequest, opts ...grpc.CallOption) (*CloseDrawerSessionResponse, error) {
+	out := new(CloseDrawerSessionResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosCashDrawerService/CloseDrawerSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posCashDrawerServiceClient) ReadAllDrawerSessions(ctx context.Context, in *ReadAllDrawerSessionsRequest, opts ...grpc.CallOption) (*ReadAllDrawerSessionsResponse, error) {
+	out := new(ReadAllDrawerSessionsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosCashDrawerService/ReadAllDrawerSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PosCashDrawerServiceServer is the server API for PosCashDrawerService service.
 // All implementations must embed UnimplementedPosCashDrawerServiceServer
 // for forward compatibility
@@ -91,6 +121,9 @@ type PosCashDrawerServiceServer interface {
 	UpdatePosCashDrawer(context.Context, *UpdatePosCashDrawerRequest) (*UpdatePosCashDrawerResponse, error)
 	DeletePosCashDrawer(context.Context, *DeletePosCashDrawerRequest) (*DeletePosCashDrawerResponse, error)
 	ReadAllPosCashDrawers(context.Context, *ReadAllPosCashDrawersRequest) (*ReadAllPosCashDrawersResponse, error)
+	OpenDrawerSession(context.Context, *OpenDrawerSessionRequest) (*OpenDrawerSessionResponse, error)
+	CloseDrawerSession(context.Context, *CloseDrawerSessionRequest) (*CloseDrawerSessionResponse, error)
+	ReadAllDrawerSessions(context.Context, *ReadAllDrawerSessionsRequest) (*ReadAllDrawerSessionsResponse, error)
 	mustEmbedUnimplementedPosCashDrawerServiceServer()
 }
 
@@ -113,6 +146,15 @@ func (UnimplementedPosCashDrawerServiceServer) DeletePosCashDrawer(context.Conte
 func (UnimplementedPosCashDrawerServiceServer) ReadAllPosCashDrawers(context.Context, *ReadAllPosCashDrawersRequest) (*ReadAllPosCashDrawersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosCashDrawers not implemented")
 }
+func (UnimplementedPosCashDrawerServiceServer) OpenDrawerSession(context.Context, *OpenDrawerSessionRequest) (*OpenDrawerSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDrawerSession not implemented")
+}
+func (UnimplementedPosCashDrawerServiceServer) CloseDrawerSession(context.Context, *CloseDrawerSessionRequest) (*CloseDrawerSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseDrawerSession not implemented")
+}
+func (UnimplementedPosCashDrawerServiceServer) ReadAllDrawerSessions(context.Context, *ReadAllDrawerSessionsRequest) (*ReadAllDrawerSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllDrawerSessions not implemented")
+}
 func (UnimplementedPosCashDrawerServiceServer) mustEmbedUnimplementedPosCashDrawerServiceServer() {}
 
 // UnsafePosCashDrawerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +258,60 @@ func _PosCashDrawerService_ReadAllPosCashDrawers_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PosCashDrawerService_OpenDrawerSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDrawerSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosCashDrawerServiceServer).OpenDrawerSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosCashDrawerService/OpenDrawerSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosCashDrawerServiceServer).OpenDrawerSession(ctx, req.(*OpenDrawerSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosCashDrawerService_CloseDrawerSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseDrawerSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosCashDrawerServiceServer).CloseDrawerSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosCashDrawerService/CloseDrawerSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosCashDrawerServiceServer).CloseDrawerSession(ctx, req.(*CloseDrawerSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosCashDrawerService_ReadAllDrawerSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllDrawerSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosCashDrawerServiceServer).ReadAllDrawerSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosCashDrawerService/ReadAllDrawerSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosCashDrawerServiceServer).ReadAllDrawerSessions(ctx, req.(*ReadAllDrawerSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PosCashDrawerService_ServiceDesc is the grpc.ServiceDesc for PosCashDrawerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +339,18 @@ var PosCashDrawerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadAllPosCashDrawers",
 			Handler:    _PosCashDrawerService_ReadAllPosCashDrawers_Handler,
 		},
+		{
+			MethodName: "OpenDrawerSession",
+			Handler:    _PosCashDrawerService_OpenDrawerSession_Handler,
+		},
+		{
+			MethodName: "CloseDrawerSession",
+			Handler:    _PosCashDrawerService_CloseDrawerSession_Handler,
+		},
+		{
+			MethodName: "ReadAllDrawerSessions",
+			Handler:    _PosCashDrawerService_ReadAllDrawerSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cash_drawer.proto",
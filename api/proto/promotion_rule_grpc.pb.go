@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: promotion_rule.proto
+
+package alpha_pos_system_sales_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosPromotionRuleServiceClient is the client API for PosPromotionRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosPromotionRuleServiceClient interface {
+	CreatePosPromotionRule(ctx context.Context, in *CreatePosPromotionRuleRequest, opts ...grpc.CallOption) (*CreatePosPromotionRuleResponse, error)
+	ReadPosPromotionRule(ctx context.Context, in *ReadPosPromotionRuleRequest, opts ...grpc.CallOption) (*ReadPosPromotionRuleResponse, error)
+	UpdatePosPromotionRule(ctx context.Context, in *UpdatePosPromotionRuleRequest, opts ...grpc.CallOption) (*UpdatePosPromotionRuleResponse, error)
+	DeletePosPromotionRule(ctx context.Context, in *DeletePosPromotionRuleRequest, opts ...grpc.CallOption) (*DeletePosPromotionRuleResponse, error)
+	ReadAllPosPromotionRules(ctx context.Context, in *ReadAllPosPromotionRulesRequest, opts ...grpc.CallOption) (*ReadAllPosPromotionRulesResponse, error)
+}
+
+type posPromotionRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosPromotionRuleServiceClient(cc grpc.ClientConnInterface) PosPromotionRuleServiceClient {
+	return &posPromotionRuleServiceClient{cc}
+}
+
+func (c *posPromotionRuleServiceClient) CreatePosPromotionRule(ctx context.Context, in *CreatePosPromotionRuleRequest, opts ...grpc.CallOption) (*CreatePosPromotionRuleResponse, error) {
+	out := new(CreatePosPromotionRuleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPromotionRuleService/CreatePosPromotionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPromotionRuleServiceClient) ReadPosPromotionRule(ctx context.Context, in *ReadPosPromotionRuleRequest, opts ...grpc.CallOption) (*ReadPosPromotionRuleResponse, error) {
+	out := new(ReadPosPromotionRuleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPromotionRuleService/ReadPosPromotionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPromotionRuleServiceClient) UpdatePosPromotionRule(ctx context.Context, in *UpdatePosPromotionRuleRequest, opts ...grpc.CallOption) (*UpdatePosPromotionRuleResponse, error) {
+	out := new(UpdatePosPromotionRuleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPromotionRuleService/UpdatePosPromotionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPromotionRuleServiceClient) DeletePosPromotionRule(ctx context.Context, in *DeletePosPromotionRuleRequest, opts ...grpc.CallOption) (*DeletePosPromotionRuleResponse, error) {
+	out := new(DeletePosPromotionRuleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPromotionRuleService/DeletePosPromotionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPromotionRuleServiceClient) ReadAllPosPromotionRules(ctx context.Context, in *ReadAllPosPromotionRulesRequest, opts ...grpc.CallOption) (*ReadAllPosPromotionRulesResponse, error) {
+	out := new(ReadAllPosPromotionRulesResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPromotionRuleService/ReadAllPosPromotionRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosPromotionRuleServiceServer is the server API for PosPromotionRuleService service.
+// All implementations must embed UnimplementedPosPromotionRuleServiceServer
+// for forward compatibility
+type PosPromotionRuleServiceServer interface {
+	CreatePosPromotionRule(context.Context, *CreatePosPromotionRuleRequest) (*CreatePosPromotionRuleResponse, error)
+	ReadPosPromotionRule(context.Context, *ReadPosPromotionRuleRequest) (*ReadPosPromotionRuleResponse, error)
+	UpdatePosPromotionRule(context.Context, *UpdatePosPromotionRuleRequest) (*UpdatePosPromotionRuleResponse, error)
+	DeletePosPromotionRule(context.Context, *DeletePosPromotionRuleRequest) (*DeletePosPromotionRuleResponse, error)
+	ReadAllPosPromotionRules(context.Context, *ReadAllPosPromotionRulesRequest) (*ReadAllPosPromotionRulesResponse, error)
+	mustEmbedUnimplementedPosPromotionRuleServiceServer()
+}
+
+// UnimplementedPosPromotionRuleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosPromotionRuleServiceServer struct {
+}
+
+func (UnimplementedPosPromotionRuleServiceServer) CreatePosPromotionRule(context.Context, *CreatePosPromotionRuleRequest) (*CreatePosPromotionRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosPromotionRule not implemented")
+}
+func (UnimplementedPosPromotionRuleServiceServer) ReadPosPromotionRule(context.Context, *ReadPosPromotionRuleRequest) (*ReadPosPromotionRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosPromotionRule not implemented")
+}
+func (UnimplementedPosPromotionRuleServiceServer) UpdatePosPromotionRule(context.Context, *UpdatePosPromotionRuleRequest) (*UpdatePosPromotionRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosPromotionRule not implemented")
+}
+func (UnimplementedPosPromotionRuleServiceServer) DeletePosPromotionRule(context.Context, *DeletePosPromotionRuleRequest) (*DeletePosPromotionRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosPromotionRule not implemented")
+}
+func (UnimplementedPosPromotionRuleServiceServer) ReadAllPosPromotionRules(context.Context, *ReadAllPosPromotionRulesRequest) (*ReadAllPosPromotionRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosPromotionRules not implemented")
+}
+func (UnimplementedPosPromotionRuleServiceServer) mustEmbedUnimplementedPosPromotionRuleServiceServer() {
+}
+
+// UnsafePosPromotionRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosPromotionRuleServiceServer will
+// result in compilation errors.
+type UnsafePosPromotionRuleServiceServer interface {
+	mustEmbedUnimplementedPosPromotionRuleServiceServer()
+}
+
+func RegisterPosPromotionRuleServiceServer(s grpc.ServiceRegistrar, srv PosPromotionRuleServiceServer) {
+	s.RegisterService(&PosPromotionRuleService_ServiceDesc, srv)
+}
+
+func _PosPromotionRuleService_CreatePosPromotionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosPromotionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPromotionRuleServiceServer).CreatePosPromotionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPromotionRuleService/CreatePosPromotionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPromotionRuleServiceServer).CreatePosPromotionRule(ctx, req.(*CreatePosPromotionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPromotionRuleService_ReadPosPromotionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosPromotionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPromotionRuleServiceServer).ReadPosPromotionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPromotionRuleService/ReadPosPromotionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPromotionRuleServiceServer).ReadPosPromotionRule(ctx, req.(*ReadPosPromotionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPromotionRuleService_UpdatePosPromotionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosPromotionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPromotionRuleServiceServer).UpdatePosPromotionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPromotionRuleService/UpdatePosPromotionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPromotionRuleServiceServer).UpdatePosPromotionRule(ctx, req.(*UpdatePosPromotionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPromotionRuleService_DeletePosPromotionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePosPromotionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPromotionRuleServiceServer).DeletePosPromotionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPromotionRuleService/DeletePosPromotionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPromotionRuleServiceServer).DeletePosPromotionRule(ctx, req.(*DeletePosPromotionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPromotionRuleService_ReadAllPosPromotionRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosPromotionRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPromotionRuleServiceServer).ReadAllPosPromotionRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPromotionRuleService/ReadAllPosPromotionRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPromotionRuleServiceServer).ReadAllPosPromotionRules(ctx, req.(*ReadAllPosPromotionRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosPromotionRuleService_ServiceDesc is the grpc.ServiceDesc for PosPromotionRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosPromotionRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosPromotionRuleService",
+	HandlerType: (*PosPromotionRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosPromotionRule",
+			Handler:    _PosPromotionRuleService_CreatePosPromotionRule_Handler,
+		},
+		{
+			MethodName: "ReadPosPromotionRule",
+			Handler:    _PosPromotionRuleService_ReadPosPromotionRule_Handler,
+		},
+		{
+			MethodName: "UpdatePosPromotionRule",
+			Handler:    _PosPromotionRuleService_UpdatePosPromotionRule_Handler,
+		},
+		{
+			MethodName: "DeletePosPromotionRule",
+			Handler:    _PosPromotionRuleService_DeletePosPromotionRule_Handler,
+		},
+		{
+			MethodName: "ReadAllPosPromotionRules",
+			Handler:    _PosPromotionRuleService_ReadAllPosPromotionRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "promotion_rule.proto",
+}
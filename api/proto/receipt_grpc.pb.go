@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: receipt.proto
+
+package alpha_pos_system_sales_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosReceiptServiceClient is the client API for PosReceiptService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosReceiptServiceClient interface {
+	CreatePosReceipt(ctx context.Context, in *CreatePosReceiptRequest, opts ...grpc.CallOption) (*CreatePosReceiptResponse, error)
+	ReadPosReceipt(ctx context.Context, in *ReadPosReceiptRequest, opts ...grpc.CallOption) (*ReadPosReceiptResponse, error)
+	VoidPosReceipt(ctx context.Context, in *VoidPosReceiptRequest, opts ...grpc.CallOption) (*VoidPosReceiptResponse, error)
+	ReadAllPosReceipts(ctx context.Context, in *ReadAllPosReceiptsRequest, opts ...grpc.CallOption) (*ReadAllPosReceiptsResponse, error)
+}
+
+type posReceiptServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosReceiptServiceClient(cc grpc.ClientConnInterface) PosReceiptServiceClient {
+	return &posReceiptServiceClient{cc}
+}
+
+func (c *posReceiptServiceClient) CreatePosReceipt(ctx context.Context, in *CreatePosReceiptRequest, opts ...grpc.CallOption) (*CreatePosReceiptResponse, error) {
+	out := new(CreatePosReceiptResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosReceiptService/CreatePosReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posReceiptServiceClient) ReadPosReceipt(ctx context.Context, in *ReadPosReceiptRequest, opts ...grpc.CallOption) (*ReadPosReceiptResponse, error) {
+	out := new(ReadPosReceiptResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosReceiptService/ReadPosReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posReceiptServiceClient) VoidPosReceipt(ctx context.Context, in *VoidPosReceiptRequest, opts ...grpc.CallOption) (*VoidPosReceiptResponse, error) {
+	out := new(VoidPosReceiptResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosReceiptService/VoidPosReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posReceiptServiceClient) ReadAllPosReceipts(ctx context.Context, in *ReadAllPosReceiptsRequest, opts ...grpc.CallOption) (*ReadAllPosReceiptsResponse, error) {
+	out := new(ReadAllPosReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosReceiptService/ReadAllPosReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosReceiptServiceServer is the server API for PosReceiptService service.
+// All implementations must embed UnimplementedPosReceiptServiceServer
+// for forward compatibility
+type PosReceiptServiceServer interface {
+	CreatePosReceipt(context.Context, *CreatePosReceiptRequest) (*CreatePosReceiptResponse, error)
+	ReadPosReceipt(context.Context, *ReadPosReceiptRequest) (*ReadPosReceiptResponse, error)
+	VoidPosReceipt(context.Context, *VoidPosReceiptRequest) (*VoidPosReceiptResponse, error)
+	ReadAllPosReceipts(context.Context, *ReadAllPosReceiptsRequest) (*ReadAllPosReceiptsResponse, error)
+	mustEmbedUnimplementedPosReceiptServiceServer()
+}
+
+// UnimplementedPosReceiptServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosReceiptServiceServer struct {
+}
+
+func (UnimplementedPosReceiptServiceServer) CreatePosReceipt(context.Context, *CreatePosReceiptRequest) (*CreatePosReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosReceipt not implemented")
+}
+func (UnimplementedPosReceiptServiceServer) ReadPosReceipt(context.Context, *ReadPosReceiptRequest) (*ReadPosReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosReceipt not implemented")
+}
+func (UnimplementedPosReceiptServiceServer) VoidPosReceipt(context.Context, *VoidPosReceiptRequest) (*VoidPosReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidPosReceipt not implemented")
+}
+func (UnimplementedPosReceiptServiceServer) ReadAllPosReceipts(context.Context, *ReadAllPosReceiptsRequest) (*ReadAllPosReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosReceipts not implemented")
+}
+func (UnimplementedPosReceiptServiceServer) mustEmbedUnimplementedPosReceiptServiceServer() {}
+
+// UnsafePosReceiptServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosReceiptServiceServer will
+// result in compilation errors.
+type UnsafePosReceiptServiceServer interface {
+	mustEmbedUnimplementedPosReceiptServiceServer()
+}
+
+func RegisterPosReceiptServiceServer(s grpc.ServiceRegistrar, srv PosReceiptServiceServer) {
+	s.RegisterService(&PosReceiptService_ServiceDesc, srv)
+}
+
+func _PosReceiptService_CreatePosReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosReceiptServiceServer).CreatePosReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosReceiptService/CreatePosReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosReceiptServiceServer).CreatePosReceipt(ctx, req.(*CreatePosReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosReceiptService_ReadPosReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosReceiptServiceServer).ReadPosReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosReceiptService/ReadPosReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosReceiptServiceServer).ReadPosReceipt(ctx, req.(*ReadPosReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosReceiptService_VoidPosReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidPosReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosReceiptServiceServer).VoidPosReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosReceiptService/VoidPosReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosReceiptServiceServer).VoidPosReceipt(ctx, req.(*VoidPosReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosReceiptService_ReadAllPosReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosReceiptServiceServer).ReadAllPosReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosReceiptService/ReadAllPosReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosReceiptServiceServer).ReadAllPosReceipts(ctx, req.(*ReadAllPosReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosReceiptService_ServiceDesc is the grpc.ServiceDesc for PosReceiptService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosReceiptService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosReceiptService",
+	HandlerType: (*PosReceiptServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosReceipt",
+			Handler:    _PosReceiptService_CreatePosReceipt_Handler,
+		},
+		{
+			MethodName: "ReadPosReceipt",
+			Handler:    _PosReceiptService_ReadPosReceipt_Handler,
+		},
+		{
+			MethodName: "VoidPosReceipt",
+			Handler:    _PosReceiptService_VoidPosReceipt_Handler,
+		},
+		{
+			MethodName: "ReadAllPosReceipts",
+			Handler:    _PosReceiptService_ReadAllPosReceipts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "receipt.proto",
+}
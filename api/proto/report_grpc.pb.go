@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: report.proto
+
+package alpha_pos_system_sales_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosReportServiceClient is the client API for PosReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosReportServiceClient interface {
+	GetDailyStoreSummary(ctx context.Context, in *GetDailyStoreSummaryRequest, opts ...grpc.CallOption) (*GetDailyStoreSummaryResponse, error)
+	GetSalesTimeSeries(ctx context.Context, in *GetSalesTimeSeriesRequest, opts ...grpc.CallOption) (*GetSalesTimeSeriesResponse, error)
+}
+
+type posReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosReportServiceClient(cc grpc.ClientConnInterface) PosReportServiceClient {
+	return &posReportServiceClient{cc}
+}
+
+func (c *posReportServiceClient) GetDailyStoreSummary(ctx context.Context, in *GetDailyStoreSummaryRequest, opts ...grpc.CallOption) (*GetDailyStoreSummaryResponse, error) {
+	out := new(GetDailyStoreSummaryResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosReportService/GetDailyStoreSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posReportServiceClient) GetSalesTimeSeries(ctx context.Context, in *GetSalesTimeSeriesRequest, opts ...grpc.CallOption) (*GetSalesTimeSeriesResponse, error) {
+	out := new(GetSalesTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosReportService/GetSalesTimeSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosReportServiceServer is the server API for PosReportService service.
+// All implementations must embed UnimplementedPosReportServiceServer
+// for forward compatibility
+type PosReportServiceServer interface {
+	GetDailyStoreSummary(context.Context, *GetDailyStoreSummaryRequest) (*GetDailyStoreSummaryResponse, error)
+	GetSalesTimeSeries(context.Context, *GetSalesTimeSeriesRequest) (*GetSalesTimeSeriesResponse, error)
+	mustEmbedUnimplementedPosReportServiceServer()
+}
+
+// UnimplementedPosReportServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosReportServiceServer struct {
+}
+
+func (UnimplementedPosReportServiceServer) GetDailyStoreSummary(context.Context, *GetDailyStoreSummaryRequest) (*GetDailyStoreSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyStoreSummary not implemented")
+}
+func (UnimplementedPosReportServiceServer) GetSalesTimeSeries(context.Context, *GetSalesTimeSeriesRequest) (*GetSalesTimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesTimeSeries not implemented")
+}
+func (UnimplementedPosReportServiceServer) mustEmbedUnimplementedPosReportServiceServer() {}
+
+// UnsafePosReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosReportServiceServer will
+// result in compilation errors.
+type UnsafePosReportServiceServer interface {
+	mustEmbedUnimplementedPosReportServiceServer()
+}
+
+func RegisterPosReportServiceServer(s grpc.ServiceRegistrar, srv PosReportServiceServer) {
+	s.RegisterService(&PosReportService_ServiceDesc, srv)
+}
+
+func _PosReportService_GetDailyStoreSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyStoreSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosReportServiceServer).GetDailyStoreSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosReportService/GetDailyStoreSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosReportServiceServer).GetDailyStoreSummary(ctx, req.(*GetDailyStoreSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosReportService_GetSalesTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosReportServiceServer).GetSalesTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosReportService/GetSalesTimeSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosReportServiceServer).GetSalesTimeSeries(ctx, req.(*GetSalesTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosReportService_ServiceDesc is the grpc.ServiceDesc for PosReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosReportService",
+	HandlerType: (*PosReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDailyStoreSummary",
+			Handler:    _PosReportService_GetDailyStoreSummary_Handler,
+		},
+		{
+			MethodName: "GetSalesTimeSeries",
+			Handler:    _PosReportService_GetSalesTimeSeries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "report.proto",
+}
@@ -32,9 +32,9 @@ type PosSale struct {
 	ProductId       string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	CustomerId      string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price           float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Price           *Money                 `protobuf:"bytes,22,opt,name=price,proto3" json:"price,omitempty"`
 	SaleDate        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=sale_date,json=saleDate,proto3" json:"sale_date,omitempty"`
-	TotalPrice      float64                `protobuf:"fixed64,8,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	TotalPrice      *Money                 `protobuf:"bytes,23,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	StoreId         string                 `protobuf:"bytes,9,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 	CashierId       string                 `protobuf:"bytes,10,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
 	PaymentMethodId string                 `protobuf:"bytes,11,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
@@ -44,6 +44,9 @@ type PosSale struct {
 	CreatedBy       string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	UpdatedBy       string                 `protobuf:"bytes,18,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	TaxAmount       *Money                 `protobuf:"bytes,24,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	DiscountAmount  *Money                 `protobuf:"bytes,25,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	PromotionId     string                 `protobuf:"bytes,21,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
 }
 
 func (x *PosSale) Reset() {
@@ -113,11 +116,11 @@ func (x *PosSale) GetQuantity() int32 {
 	return 0
 }
 
-func (x *PosSale) GetPrice() float64 {
+func (x *PosSale) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *PosSale) GetSaleDate() *timestamppb.Timestamp {
@@ -127,11 +130,11 @@ func (x *PosSale) GetSaleDate() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *PosSale) GetTotalPrice() float64 {
+func (x *PosSale) GetTotalPrice() *Money {
 	if x != nil {
 		return x.TotalPrice
 	}
-	return 0
+	return nil
 }
 
 func (x *PosSale) GetStoreId() string {
@@ -197,21 +200,108 @@ func (x *PosSale) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *PosSale) GetTaxAmount() *Money {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return nil
+}
+
+func (x *PosSale) GetDiscountAmount() *Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *PosSale) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+// PosTender is one payment method and the amount paid with it.
+// For cash, cash_tendered is the cash handed over by the customer, the difference to amount is given back as change.
+type PosTender struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethodId string `protobuf:"bytes,1,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	Amount          *Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CashTendered    *Money `protobuf:"bytes,5,opt,name=cash_tendered,json=cashTendered,proto3" json:"cash_tendered,omitempty"`
+}
+
+func (x *PosTender) Reset() {
+	*x = PosTender{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sales_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosTender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosTender) ProtoMessage() {}
+
+func (x *PosTender) ProtoReflect() protoreflect.Message {
+	mi := &file_sales_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosTender.ProtoReflect.Descriptor instead.
+func (*PosTender) Descriptor() ([]byte, []int) {
+	return file_sales_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PosTender) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *PosTender) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PosTender) GetCashTendered() *Money {
+	if x != nil {
+		return x.CashTendered
+	}
+	return nil
+}
+
 // Request and Response messages
 type CreatePosSalesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PosSales   []*PosSale  `protobuf:"bytes,1,rep,name=pos_sales,json=posSales,proto3" json:"pos_sales,omitempty"`
-	JwtPayload *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
-	JwtToken   string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	PosSales   []*PosSale   `protobuf:"bytes,1,rep,name=pos_sales,json=posSales,proto3" json:"pos_sales,omitempty"`
+	JwtPayload *JWTPayload  `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken   string       `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	Tenders    []*PosTender `protobuf:"bytes,4,rep,name=tenders,proto3" json:"tenders,omitempty"`
 }
 
 func (x *CreatePosSalesRequest) Reset() {
 	*x = CreatePosSalesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[1]
+		mi := &file_sales_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -224,7 +314,7 @@ func (x *CreatePosSalesRequest) String() string {
 func (*CreatePosSalesRequest) ProtoMessage() {}
 
 func (x *CreatePosSalesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[1]
+	mi := &file_sales_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +327,7 @@ func (x *CreatePosSalesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePosSalesRequest.ProtoReflect.Descriptor instead.
 func (*CreatePosSalesRequest) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{1}
+	return file_sales_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePosSalesRequest) GetPosSales() []*PosSale {
@@ -261,18 +351,27 @@ func (x *CreatePosSalesRequest) GetJwtToken() string {
 	return ""
 }
 
+func (x *CreatePosSalesRequest) GetTenders() []*PosTender {
+	if x != nil {
+		return x.Tenders
+	}
+	return nil
+}
+
 type CreatePosSalesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PosSales []*PosSale `protobuf:"bytes,1,rep,name=pos_sales,json=posSales,proto3" json:"pos_sales,omitempty"`
+	PosSales     []*PosSale `protobuf:"bytes,1,rep,name=pos_sales,json=posSales,proto3" json:"pos_sales,omitempty"`
+	CashTendered *Money     `protobuf:"bytes,4,opt,name=cash_tendered,json=cashTendered,proto3" json:"cash_tendered,omitempty"`
+	ChangeAmount *Money     `protobuf:"bytes,5,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
 }
 
 func (x *CreatePosSalesResponse) Reset() {
 	*x = CreatePosSalesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[2]
+		mi := &file_sales_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -285,7 +384,7 @@ func (x *CreatePosSalesResponse) String() string {
 func (*CreatePosSalesResponse) ProtoMessage() {}
 
 func (x *CreatePosSalesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[2]
+	mi := &file_sales_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +397,7 @@ func (x *CreatePosSalesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePosSalesResponse.ProtoReflect.Descriptor instead.
 func (*CreatePosSalesResponse) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{2}
+	return file_sales_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePosSalesResponse) GetPosSales() []*PosSale {
@@ -308,6 +407,20 @@ func (x *CreatePosSalesResponse) GetPosSales() []*PosSale {
 	return nil
 }
 
+func (x *CreatePosSalesResponse) GetCashTendered() *Money {
+	if x != nil {
+		return x.CashTendered
+	}
+	return nil
+}
+
+func (x *CreatePosSalesResponse) GetChangeAmount() *Money {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return nil
+}
+
 type ReadPosSaleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -321,7 +434,7 @@ type ReadPosSaleRequest struct {
 func (x *ReadPosSaleRequest) Reset() {
 	*x = ReadPosSaleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[3]
+		mi := &file_sales_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -334,7 +447,7 @@ func (x *ReadPosSaleRequest) String() string {
 func (*ReadPosSaleRequest) ProtoMessage() {}
 
 func (x *ReadPosSaleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[3]
+	mi := &file_sales_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +460,7 @@ func (x *ReadPosSaleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadPosSaleRequest.ProtoReflect.Descriptor instead.
 func (*ReadPosSaleRequest) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{3}
+	return file_sales_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ReadPosSaleRequest) GetSaleId() string {
@@ -382,7 +495,7 @@ type ReadPosSaleResponse struct {
 func (x *ReadPosSaleResponse) Reset() {
 	*x = ReadPosSaleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[4]
+		mi := &file_sales_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -395,7 +508,7 @@ func (x *ReadPosSaleResponse) String() string {
 func (*ReadPosSaleResponse) ProtoMessage() {}
 
 func (x *ReadPosSaleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[4]
+	mi := &file_sales_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +521,7 @@ func (x *ReadPosSaleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadPosSaleResponse.ProtoReflect.Descriptor instead.
 func (*ReadPosSaleResponse) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{4}
+	return file_sales_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReadPosSaleResponse) GetPosSale() *PosSale {
@@ -431,7 +544,7 @@ type UpdatePosSaleRequest struct {
 func (x *UpdatePosSaleRequest) Reset() {
 	*x = UpdatePosSaleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[5]
+		mi := &file_sales_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -444,7 +557,7 @@ func (x *UpdatePosSaleRequest) String() string {
 func (*UpdatePosSaleRequest) ProtoMessage() {}
 
 func (x *UpdatePosSaleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[5]
+	mi := &file_sales_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +570,7 @@ func (x *UpdatePosSaleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePosSaleRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePosSaleRequest) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{5}
+	return file_sales_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdatePosSaleRequest) GetPosSale() *PosSale {
@@ -492,7 +605,7 @@ type UpdatePosSaleResponse struct {
 func (x *UpdatePosSaleResponse) Reset() {
 	*x = UpdatePosSaleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[6]
+		mi := &file_sales_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -505,7 +618,7 @@ func (x *UpdatePosSaleResponse) String() string {
 func (*UpdatePosSaleResponse) ProtoMessage() {}
 
 func (x *UpdatePosSaleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[6]
+	mi := &file_sales_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +631,7 @@ func (x *UpdatePosSaleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePosSaleResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePosSaleResponse) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{6}
+	return file_sales_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdatePosSaleResponse) GetPosSale() *PosSale {
@@ -541,7 +654,7 @@ type DeletePosSaleRequest struct {
 func (x *DeletePosSaleRequest) Reset() {
 	*x = DeletePosSaleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[7]
+		mi := &file_sales_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +667,7 @@ func (x *DeletePosSaleRequest) String() string {
 func (*DeletePosSaleRequest) ProtoMessage() {}
 
 func (x *DeletePosSaleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[7]
+	mi := &file_sales_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +680,7 @@ func (x *DeletePosSaleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePosSaleRequest.ProtoReflect.Descriptor instead.
 func (*DeletePosSaleRequest) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{7}
+	return file_sales_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeletePosSaleRequest) GetSaleId() string {
@@ -602,7 +715,7 @@ type DeletePosSaleResponse struct {
 func (x *DeletePosSaleResponse) Reset() {
 	*x = DeletePosSaleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[8]
+		mi := &file_sales_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -615,7 +728,7 @@ func (x *DeletePosSaleResponse) String() string {
 func (*DeletePosSaleResponse) ProtoMessage() {}
 
 func (x *DeletePosSaleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[8]
+	mi := &file_sales_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +741,7 @@ func (x *DeletePosSaleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePosSaleResponse.ProtoReflect.Descriptor instead.
 func (*DeletePosSaleResponse) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{8}
+	return file_sales_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeletePosSaleResponse) GetSuccess() bool {
@@ -643,16 +756,19 @@ type ReadAllPosSalesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit      int32       `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page       int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	JwtPayload *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
-	JwtToken   string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	Limit         int32       `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	JwtPayload    *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken      string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	Query         *ListQuery  `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	PageToken     string      `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`              // next_page_token of the previous page, used when limit is set without a page
+	EstimateCount bool        `protobuf:"varint,7,opt,name=estimate_count,json=estimateCount,proto3" json:"estimate_count,omitempty"` // count from the query planner instead of counting every record
 }
 
 func (x *ReadAllPosSalesRequest) Reset() {
 	*x = ReadAllPosSalesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[9]
+		mi := &file_sales_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -665,7 +781,7 @@ func (x *ReadAllPosSalesRequest) String() string {
 func (*ReadAllPosSalesRequest) ProtoMessage() {}
 
 func (x *ReadAllPosSalesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[9]
+	mi := &file_sales_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +794,7 @@ func (x *ReadAllPosSalesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAllPosSalesRequest.ProtoReflect.Descriptor instead.
 func (*ReadAllPosSalesRequest) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{9}
+	return file_sales_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReadAllPosSalesRequest) GetLimit() int32 {
@@ -709,22 +825,45 @@ func (x *ReadAllPosSalesRequest) GetJwtToken() string {
 	return ""
 }
 
+func (x *ReadAllPosSalesRequest) GetQuery() *ListQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ReadAllPosSalesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadAllPosSalesRequest) GetEstimateCount() bool {
+	if x != nil {
+		return x.EstimateCount
+	}
+	return false
+}
+
 type ReadAllPosSalesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PosSales []*PosSale `protobuf:"bytes,1,rep,name=pos_sales,json=posSales,proto3" json:"pos_sales,omitempty"`
-	Limit    int32      `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page     int32      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	MaxPage  int32      `protobuf:"varint,4,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
-	Count    int64      `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	PosSales       []*PosSale `protobuf:"bytes,1,rep,name=pos_sales,json=posSales,proto3" json:"pos_sales,omitempty"`
+	Limit          int32      `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page           int32      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	MaxPage        int32      `protobuf:"varint,4,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
+	Count          int64      `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	NextPageToken  string     `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	CountEstimated bool       `protobuf:"varint,7,opt,name=count_estimated,json=countEstimated,proto3" json:"count_estimated,omitempty"`
 }
 
 func (x *ReadAllPosSalesResponse) Reset() {
 	*x = ReadAllPosSalesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sales_proto_msgTypes[10]
+		mi := &file_sales_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -737,7 +876,7 @@ func (x *ReadAllPosSalesResponse) String() string {
 func (*ReadAllPosSalesResponse) ProtoMessage() {}
 
 func (x *ReadAllPosSalesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sales_proto_msgTypes[10]
+	mi := &file_sales_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +889,7 @@ func (x *ReadAllPosSalesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAllPosSalesResponse.ProtoReflect.Descriptor instead.
 func (*ReadAllPosSalesResponse) Descriptor() ([]byte, []int) {
-	return file_sales_proto_rawDescGZIP(), []int{10}
+	return file_sales_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReadAllPosSalesResponse) GetPosSales() []*PosSale {
@@ -788,6 +927,20 @@ func (x *ReadAllPosSalesResponse) GetCount() int64 {
 	return 0
 }
 
+func (x *ReadAllPosSalesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadAllPosSalesResponse) GetCountEstimated() bool {
+	if x != nil {
+		return x.CountEstimated
+	}
+	return false
+}
+
 var File_sales_proto protoreflect.FileDescriptor
 
 var file_sales_proto_rawDesc = []byte{
@@ -795,104 +948,142 @@ var file_sales_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xe3, 0x04, 0x0a, 0x07, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x17, 0x0a,
+	0x6f, 0x1a, 0x0b, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96,
+	0x06, 0x0a, 0x07, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x73, 0x61, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x69, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x29, 0x0a, 0x0a,
+	0x74, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x74, 0x61,
+	0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x4a,
+	0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x13, 0x10,
+	0x14, 0x4a, 0x04, 0x08, 0x14, 0x10, 0x15, 0x22, 0x98, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x54,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
+	0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x73, 0x68, 0x54, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x22, 0xbb, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a,
+	0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x54, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0xb1, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0d, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x73, 0x68, 0x54,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04,
+	0x08, 0x03, 0x10, 0x04, 0x22, 0x7c, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53,
+	0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a,
+	0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x3e, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x53, 0x61,
+	0x6c, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x6f, 0x73,
+	0x53, 0x61, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x40, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x6f,
+	0x73, 0x53, 0x61, 0x6c, 0x65, 0x22, 0x7e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
 	0x07, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x61, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x61, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x61, 0x73, 0x68, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61,
-	0x6c, 0x65, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b,
-	0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x43, 0x0a, 0x16, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50,
-	0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73,
-	0x22, 0x7c, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x65, 0x49, 0x64, 0x12,
-	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3e,
-	0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
-	0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x22, 0x8e,
-	0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x5f, 0x73,
-	0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
-	0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65,
-	0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x40, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x5f,
-	0x73, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x53, 0x61, 0x6c,
-	0x65, 0x22, 0x7e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x65,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57,
-	0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c,
-	0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74,
-	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
-	0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61,
+	0x73, 0x61, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77,
+	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a,
+	0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61,
 	0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x65,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
@@ -902,35 +1093,40 @@ var file_sales_proto_rawDesc = []byte{
 	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
 	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78,
 	0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xfb, 0x02, 0x0a, 0x0e, 0x50,
-	0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12,
-	0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53,
-	0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64,
-	0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x61,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x6f,
-	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53,
-	0x61, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x2e,
-	0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x61,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x73,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x69,
-	0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2d, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x32, 0xfb, 0x02, 0x0a, 0x0e,
+	0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73,
+	0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
+	0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x61,
+	0x64, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53,
+	0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70,
+	0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x53, 0x61, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53,
+	0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x52,
+	0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x1b,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53,
+	0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2d, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -945,52 +1141,65 @@ func file_sales_proto_rawDescGZIP() []byte {
 	return file_sales_proto_rawDescData
 }
 
-var file_sales_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_sales_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_sales_proto_goTypes = []interface{}{
 	(*PosSale)(nil),                 // 0: pos.PosSale
-	(*CreatePosSalesRequest)(nil),   // 1: pos.CreatePosSalesRequest
-	(*CreatePosSalesResponse)(nil),  // 2: pos.CreatePosSalesResponse
-	(*ReadPosSaleRequest)(nil),      // 3: pos.ReadPosSaleRequest
-	(*ReadPosSaleResponse)(nil),     // 4: pos.ReadPosSaleResponse
-	(*UpdatePosSaleRequest)(nil),    // 5: pos.UpdatePosSaleRequest
-	(*UpdatePosSaleResponse)(nil),   // 6: pos.UpdatePosSaleResponse
-	(*DeletePosSaleRequest)(nil),    // 7: pos.DeletePosSaleRequest
-	(*DeletePosSaleResponse)(nil),   // 8: pos.DeletePosSaleResponse
-	(*ReadAllPosSalesRequest)(nil),  // 9: pos.ReadAllPosSalesRequest
-	(*ReadAllPosSalesResponse)(nil), // 10: pos.ReadAllPosSalesResponse
-	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
-	(*JWTPayload)(nil),              // 12: pos.JWTPayload
+	(*PosTender)(nil),               // 1: pos.PosTender
+	(*CreatePosSalesRequest)(nil),   // 2: pos.CreatePosSalesRequest
+	(*CreatePosSalesResponse)(nil),  // 3: pos.CreatePosSalesResponse
+	(*ReadPosSaleRequest)(nil),      // 4: pos.ReadPosSaleRequest
+	(*ReadPosSaleResponse)(nil),     // 5: pos.ReadPosSaleResponse
+	(*UpdatePosSaleRequest)(nil),    // 6: pos.UpdatePosSaleRequest
+	(*UpdatePosSaleResponse)(nil),   // 7: pos.UpdatePosSaleResponse
+	(*DeletePosSaleRequest)(nil),    // 8: pos.DeletePosSaleRequest
+	(*DeletePosSaleResponse)(nil),   // 9: pos.DeletePosSaleResponse
+	(*ReadAllPosSalesRequest)(nil),  // 10: pos.ReadAllPosSalesRequest
+	(*ReadAllPosSalesResponse)(nil), // 11: pos.ReadAllPosSalesResponse
+	(*Money)(nil),                   // 12: pos.Money
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+	(*JWTPayload)(nil),              // 14: pos.JWTPayload
+	(*ListQuery)(nil),               // 15: pos.ListQuery
 }
 var file_sales_proto_depIdxs = []int32{
-	11, // 0: pos.PosSale.sale_date:type_name -> google.protobuf.Timestamp
-	11, // 1: pos.PosSale.created_at:type_name -> google.protobuf.Timestamp
-	11, // 2: pos.PosSale.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: pos.CreatePosSalesRequest.pos_sales:type_name -> pos.PosSale
-	12, // 4: pos.CreatePosSalesRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 5: pos.CreatePosSalesResponse.pos_sales:type_name -> pos.PosSale
-	12, // 6: pos.ReadPosSaleRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 7: pos.ReadPosSaleResponse.pos_sale:type_name -> pos.PosSale
-	0,  // 8: pos.UpdatePosSaleRequest.pos_sale:type_name -> pos.PosSale
-	12, // 9: pos.UpdatePosSaleRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 10: pos.UpdatePosSaleResponse.pos_sale:type_name -> pos.PosSale
-	12, // 11: pos.DeletePosSaleRequest.jwt_payload:type_name -> pos.JWTPayload
-	12, // 12: pos.ReadAllPosSalesRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 13: pos.ReadAllPosSalesResponse.pos_sales:type_name -> pos.PosSale
-	1,  // 14: pos.PosSaleService.CreatePosSales:input_type -> pos.CreatePosSalesRequest
-	3,  // 15: pos.PosSaleService.ReadPosSale:input_type -> pos.ReadPosSaleRequest
-	5,  // 16: pos.PosSaleService.UpdatePosSale:input_type -> pos.UpdatePosSaleRequest
-	7,  // 17: pos.PosSaleService.DeletePosSale:input_type -> pos.DeletePosSaleRequest
-	9,  // 18: pos.PosSaleService.ReadAllPosSales:input_type -> pos.ReadAllPosSalesRequest
-	2,  // 19: pos.PosSaleService.CreatePosSales:output_type -> pos.CreatePosSalesResponse
-	4,  // 20: pos.PosSaleService.ReadPosSale:output_type -> pos.ReadPosSaleResponse
-	6,  // 21: pos.PosSaleService.UpdatePosSale:output_type -> pos.UpdatePosSaleResponse
-	8,  // 22: pos.PosSaleService.DeletePosSale:output_type -> pos.DeletePosSaleResponse
-	10, // 23: pos.PosSaleService.ReadAllPosSales:output_type -> pos.ReadAllPosSalesResponse
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	12, // 0: pos.PosSale.price:type_name -> pos.Money
+	13, // 1: pos.PosSale.sale_date:type_name -> google.protobuf.Timestamp
+	12, // 2: pos.PosSale.total_price:type_name -> pos.Money
+	13, // 3: pos.PosSale.created_at:type_name -> google.protobuf.Timestamp
+	13, // 4: pos.PosSale.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 5: pos.PosSale.tax_amount:type_name -> pos.Money
+	12, // 6: pos.PosSale.discount_amount:type_name -> pos.Money
+	12, // 7: pos.PosTender.amount:type_name -> pos.Money
+	12, // 8: pos.PosTender.cash_tendered:type_name -> pos.Money
+	0,  // 9: pos.CreatePosSalesRequest.pos_sales:type_name -> pos.PosSale
+	14, // 10: pos.CreatePosSalesRequest.jwt_payload:type_name -> pos.JWTPayload
+	1,  // 11: pos.CreatePosSalesRequest.tenders:type_name -> pos.PosTender
+	0,  // 12: pos.CreatePosSalesResponse.pos_sales:type_name -> pos.PosSale
+	12, // 13: pos.CreatePosSalesResponse.cash_tendered:type_name -> pos.Money
+	12, // 14: pos.CreatePosSalesResponse.change_amount:type_name -> pos.Money
+	14, // 15: pos.ReadPosSaleRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 16: pos.ReadPosSaleResponse.pos_sale:type_name -> pos.PosSale
+	0,  // 17: pos.UpdatePosSaleRequest.pos_sale:type_name -> pos.PosSale
+	14, // 18: pos.UpdatePosSaleRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 19: pos.UpdatePosSaleResponse.pos_sale:type_name -> pos.PosSale
+	14, // 20: pos.DeletePosSaleRequest.jwt_payload:type_name -> pos.JWTPayload
+	14, // 21: pos.ReadAllPosSalesRequest.jwt_payload:type_name -> pos.JWTPayload
+	15, // 22: pos.ReadAllPosSalesRequest.query:type_name -> pos.ListQuery
+	0,  // 23: pos.ReadAllPosSalesResponse.pos_sales:type_name -> pos.PosSale
+	2,  // 24: pos.PosSaleService.CreatePosSales:input_type -> pos.CreatePosSalesRequest
+	4,  // 25: pos.PosSaleService.ReadPosSale:input_type -> pos.ReadPosSaleRequest
+	6,  // 26: pos.PosSaleService.UpdatePosSale:input_type -> pos.UpdatePosSaleRequest
+	8,  // 27: pos.PosSaleService.DeletePosSale:input_type -> pos.DeletePosSaleRequest
+	10, // 28: pos.PosSaleService.ReadAllPosSales:input_type -> pos.ReadAllPosSalesRequest
+	3,  // 29: pos.PosSaleService.CreatePosSales:output_type -> pos.CreatePosSalesResponse
+	5,  // 30: pos.PosSaleService.ReadPosSale:output_type -> pos.ReadPosSaleResponse
+	7,  // 31: pos.PosSaleService.UpdatePosSale:output_type -> pos.UpdatePosSaleResponse
+	9,  // 32: pos.PosSaleService.DeletePosSale:output_type -> pos.DeletePosSaleResponse
+	11, // 33: pos.PosSaleService.ReadAllPosSales:output_type -> pos.ReadAllPosSalesResponse
+	29, // [29:34] is the sub-list for method output_type
+	24, // [24:29] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_sales_proto_init() }
@@ -999,6 +1208,7 @@ func file_sales_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_money_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_sales_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PosSale); i {
@@ -1013,7 +1223,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePosSalesRequest); i {
+			switch v := v.(*PosTender); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1025,7 +1235,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePosSalesResponse); i {
+			switch v := v.(*CreatePosSalesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1037,7 +1247,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPosSaleRequest); i {
+			switch v := v.(*CreatePosSalesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1049,7 +1259,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPosSaleResponse); i {
+			switch v := v.(*ReadPosSaleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1061,7 +1271,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePosSaleRequest); i {
+			switch v := v.(*ReadPosSaleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1073,7 +1283,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePosSaleResponse); i {
+			switch v := v.(*UpdatePosSaleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1085,7 +1295,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePosSaleRequest); i {
+			switch v := v.(*UpdatePosSaleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1097,7 +1307,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePosSaleResponse); i {
+			switch v := v.(*DeletePosSaleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1109,7 +1319,7 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAllPosSalesRequest); i {
+			switch v := v.(*DeletePosSaleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1121,6 +1331,18 @@ func file_sales_proto_init() {
 			}
 		}
 		file_sales_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllPosSalesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sales_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadAllPosSalesResponse); i {
 			case 0:
 				return &v.state
@@ -1139,7 +1361,7 @@ func file_sales_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sales_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: tax_rate.proto
+
+package alpha_pos_system_sales_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosTaxRateServiceClient is the client API for PosTaxRateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosTaxRateServiceClient interface {
+	CreatePosTaxRate(ctx context.Context, in *CreatePosTaxRateRequest, opts ...grpc.CallOption) (*CreatePosTaxRateResponse, error)
+	ReadPosTaxRate(ctx context.Context, in *ReadPosTaxRateRequest, opts ...grpc.CallOption) (*ReadPosTaxRateResponse, error)
+	UpdatePosTaxRate(ctx context.Context, in *UpdatePosTaxRateRequest, opts ...grpc.CallOption) (*UpdatePosTaxRateResponse, error)
+	DeletePosTaxRate(ctx context.Context, in *DeletePosTaxRateRequest, opts ...grpc.CallOption) (*DeletePosTaxRateResponse, error)
+	ReadAllPosTaxRates(ctx context.Context, in *ReadAllPosTaxRatesRequest, opts ...grpc.CallOption) (*ReadAllPosTaxRatesResponse, error)
+}
+
+type posTaxRateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosTaxRateServiceClient(cc grpc.ClientConnInterface) PosTaxRateServiceClient {
+	return &posTaxRateServiceClient{cc}
+}
+
+func (c *posTaxRateServiceClient) CreatePosTaxRate(ctx context.Context, in *CreatePosTaxRateRequest, opts ...grpc.CallOption) (*CreatePosTaxRateResponse, error) {
+	out := new(CreatePosTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosTaxRateService/CreatePosTaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posTaxRateServiceClient) ReadPosTaxRate(ctx context.Context, in *ReadPosTaxRateRequest, opts ...grpc.CallOption) (*ReadPosTaxRateResponse, error) {
+	out := new(ReadPosTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosTaxRateService/ReadPosTaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posTaxRateServiceClient) UpdatePosTaxRate(ctx context.Context, in *UpdatePosTaxRateRequest, opts ...grpc.CallOption) (*UpdatePosTaxRateResponse, error) {
+	out := new(UpdatePosTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosTaxRateService/UpdatePosTaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posTaxRateServiceClient) DeletePosTaxRate(ctx context.Context, in *DeletePosTaxRateRequest, opts ...grpc.CallOption) (*DeletePosTaxRateResponse, error) {
+	out := new(DeletePosTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosTaxRateService/DeletePosTaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posTaxRateServiceClient) ReadAllPosTaxRates(ctx context.Context, in *ReadAllPosTaxRatesRequest, opts ...grpc.CallOption) (*ReadAllPosTaxRatesResponse, error) {
+	out := new(ReadAllPosTaxRatesResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosTaxRateService/ReadAllPosTaxRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosTaxRateServiceServer is the server API for PosTaxRateService service.
+// All implementations must embed UnimplementedPosTaxRateServiceServer
+// for forward compatibility
+type PosTaxRateServiceServer interface {
+	CreatePosTaxRate(context.Context, *CreatePosTaxRateRequest) (*CreatePosTaxRateResponse, error)
+	ReadPosTaxRate(context.Context, *ReadPosTaxRateRequest) (*ReadPosTaxRateResponse, error)
+	UpdatePosTaxRate(context.Context, *UpdatePosTaxRateRequest) (*UpdatePosTaxRateResponse, error)
+	DeletePosTaxRate(context.Context, *DeletePosTaxRateRequest) (*DeletePosTaxRateResponse, error)
+	ReadAllPosTaxRates(context.Context, *ReadAllPosTaxRatesRequest) (*ReadAllPosTaxRatesResponse, error)
+	mustEmbedUnimplementedPosTaxRateServiceServer()
+}
+
+// UnimplementedPosTaxRateServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosTaxRateServiceServer struct {
+}
+
+func (UnimplementedPosTaxRateServiceServer) CreatePosTaxRate(context.Context, *CreatePosTaxRateRequest) (*CreatePosTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosTaxRate not implemented")
+}
+func (UnimplementedPosTaxRateServiceServer) ReadPosTaxRate(context.Context, *ReadPosTaxRateRequest) (*ReadPosTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosTaxRate not implemented")
+}
+func (UnimplementedPosTaxRateServiceServer) UpdatePosTaxRate(context.Context, *UpdatePosTaxRateRequest) (*UpdatePosTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosTaxRate not implemented")
+}
+func (UnimplementedPosTaxRateServiceServer) DeletePosTaxRate(context.Context, *DeletePosTaxRateRequest) (*DeletePosTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosTaxRate not implemented")
+}
+func (UnimplementedPosTaxRateServiceServer) ReadAllPosTaxRates(context.Context, *ReadAllPosTaxRatesRequest) (*ReadAllPosTaxRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosTaxRates not implemented")
+}
+func (UnimplementedPosTaxRateServiceServer) mustEmbedUnimplementedPosTaxRateServiceServer() {}
+
+// UnsafePosTaxRateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosTaxRateServiceServer will
+// result in compilation errors.
+type UnsafePosTaxRateServiceServer interface {
+	mustEmbedUnimplementedPosTaxRateServiceServer()
+}
+
+func RegisterPosTaxRateServiceServer(s grpc.ServiceRegistrar, srv PosTaxRateServiceServer) {
+	s.RegisterService(&PosTaxRateService_ServiceDesc, srv)
+}
+
+func _PosTaxRateService_CreatePosTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosTaxRateServiceServer).CreatePosTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosTaxRateService/CreatePosTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosTaxRateServiceServer).CreatePosTaxRate(ctx, req.(*CreatePosTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosTaxRateService_ReadPosTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosTaxRateServiceServer).ReadPosTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosTaxRateService/ReadPosTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosTaxRateServiceServer).ReadPosTaxRate(ctx, req.(*ReadPosTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosTaxRateService_UpdatePosTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosTaxRateServiceServer).UpdatePosTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosTaxRateService/UpdatePosTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosTaxRateServiceServer).UpdatePosTaxRate(ctx, req.(*UpdatePosTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosTaxRateService_DeletePosTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePosTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosTaxRateServiceServer).DeletePosTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosTaxRateService/DeletePosTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosTaxRateServiceServer).DeletePosTaxRate(ctx, req.(*DeletePosTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosTaxRateService_ReadAllPosTaxRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosTaxRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosTaxRateServiceServer).ReadAllPosTaxRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosTaxRateService/ReadAllPosTaxRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosTaxRateServiceServer).ReadAllPosTaxRates(ctx, req.(*ReadAllPosTaxRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosTaxRateService_ServiceDesc is the grpc.ServiceDesc for PosTaxRateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosTaxRateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosTaxRateService",
+	HandlerType: (*PosTaxRateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosTaxRate",
+			Handler:    _PosTaxRateService_CreatePosTaxRate_Handler,
+		},
+		{
+			MethodName: "ReadPosTaxRate",
+			Handler:    _PosTaxRateService_ReadPosTaxRate_Handler,
+		},
+		{
+			MethodName: "UpdatePosTaxRate",
+			Handler:    _PosTaxRateService_UpdatePosTaxRate_Handler,
+		},
+		{
+			MethodName: "DeletePosTaxRate",
+			Handler:    _PosTaxRateService_DeletePosTaxRate_Handler,
+		},
+		{
+			MethodName: "ReadAllPosTaxRates",
+			Handler:    _PosTaxRateService_ReadAllPosTaxRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tax_rate.proto",
+}
@@ -1,34 +1,62 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"log"
+	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	// The sales series take any IANA time zone, the image may not ship a zoneinfo database
+	_ "time/tzdata"
 
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/tracing"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/upstream"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/routes"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
-	err := godotenv.Load(".env")
+	// Load and validate the settings once, the gateway does not start with a broken configuration
+	settings, err := config.LoadGatewaySettings()
 	if err != nil {
-		fmt.Println("Error is occurred  on .env file please check")
+		log.Fatalf("failed to load settings: %v", err)
 	}
 
-	clientPort := os.Getenv("CLIENT_PORT")
-	serverPort := os.Getenv("SERVER_PORT")
+	logger, err := logging.New(os.Stdout, settings.Log.Format, settings.Log.Level)
+	if err != nil {
+		log.Fatalf("failed to build the logger: %v", err)
+	}
+	slog.SetDefault(logger)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), settings.Tracing, "sales-gateway")
+	if err != nil {
+		logger.Error("failed to set up tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), settings.Server.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Warn("failed to flush the traces", slog.String("error", err.Error()))
+		}
+	}()
 
-	addr := fmt.Sprintf("localhost:%s", serverPort)
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := upstream.Dial("sales", settings.Upstream.Sales)
 	if err != nil {
-		log.Fatalf("did not connect: %v", err)
+		logger.Error("did not connect", slog.String("error", err.Error()))
+		os.Exit(1)
 	}
 	defer conn.Close()
 
@@ -39,7 +67,12 @@ func main() {
 	onlinePaymentClient := pb.NewPosOnlinePaymentServiceClient(conn)
 	paymentMethodClient := pb.NewPosPaymentMethodServiceClient(conn)
 	returnClient := pb.NewPosReturnServiceClient(conn)
+	taxRateClient := pb.NewPosTaxRateServiceClient(conn)
+	promotionRuleClient := pb.NewPosPromotionRuleServiceClient(conn)
 	saleClient := pb.NewPosSaleServiceClient(conn)
+	receiptClient := pb.NewPosReceiptServiceClient(conn)
+	reportClient := pb.NewPosReportServiceClient(conn)
+	healthClient := healthpb.NewHealthClient(conn)
 
 	// Initialize the controllers with the gRPC clients
 	cashDrawerCtrl := controller.NewPosCashDrawerController(cashDrawerClient)
@@ -48,20 +81,63 @@ func main() {
 	onlinePaymentCtrl := controller.NewPosOnlinePaymentController(onlinePaymentClient)
 	paymentMethodCtrl := controller.NewPosPaymentMethodController(paymentMethodClient)
 	returnCtrl := controller.NewPosReturnController(returnClient)
+	taxRateCtrl := controller.NewPosTaxRateController(taxRateClient)
+	promotionRuleCtrl := controller.NewPosPromotionRuleController(promotionRuleClient)
 	saleCtrl := controller.NewPosSaleController(saleClient)
+	receiptCtrl := controller.NewPosReceiptController(receiptClient)
+	reportCtrl := controller.NewPosReportController(reportClient)
+	healthCtrl := controller.NewHealthController(healthClient)
 
 	// Create a new router
-	r := gin.Default()
+	r := gin.New()
+	// Handlers pass the gin context to the gRPC clients, the request id and span live in the request context behind it
+	r.ContextWithFallback = true
+	r.Use(gin.Recovery(), tracing.GinMiddleware(), midlleware.RequestIDMiddleware(logger), metrics.GinMiddleware(), midlleware.IdempotencyKeyMiddleware())
 
 	// Define your routes
-	routes.PosCashDrawerRoutes(r, cashDrawerCtrl)
-	routes.PosCustomerRoutes(r, customerCtrl)
-	routes.PosInvoiceRoutes(r, invoiceCtrl)
-	routes.PosOnlinePaymentRoutes(r, onlinePaymentCtrl)
-	routes.PosPaymentMethodRoutes(r, paymentMethodCtrl)
-	routes.PosReturnRoutes(r, returnCtrl)
-	routes.PosSaleRoutes(r, saleCtrl)
+	routes.HealthRoutes(r, healthCtrl)
+	routes.MetricsRoutes(r)
+	routes.PosCashDrawerRoutes(r, cashDrawerCtrl, settings.Auth)
+	routes.PosCustomerRoutes(r, customerCtrl, settings.Auth)
+	routes.PosInvoiceRoutes(r, invoiceCtrl, settings.Auth)
+	routes.PosOnlinePaymentRoutes(r, onlinePaymentCtrl, settings.Auth)
+	routes.PosPaymentMethodRoutes(r, paymentMethodCtrl, settings.Auth)
+	routes.PosReturnRoutes(r, returnCtrl, settings.Auth)
+	routes.PosTaxRateRoutes(r, taxRateCtrl, settings.Auth)
+	routes.PosPromotionRuleRoutes(r, promotionRuleCtrl, settings.Auth)
+	routes.PosSaleRoutes(r, saleCtrl, settings.Auth)
+	routes.PosReceiptRoutes(r, receiptCtrl, settings.Auth)
+	routes.PosReportRoutes(r, reportCtrl, settings.Auth)
 
 	// Start the server
-	r.Run(":" + clientPort)
+	srv := &http.Server{
+		Addr:    ":" + settings.Server.HttpPort,
+		Handler: r,
+	}
+
+	// SIGINT and SIGTERM start the shutdown, in-flight requests get settings.Server.ShutdownTimeout to finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("failed to serve", slog.String("error", err.Error()))
+		}
+		return
+	case <-ctx.Done():
+		logger.Info("shutting down the HTTP server")
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), settings.Server.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("in-flight requests did not finish in time", slog.Duration("timeout", settings.Server.ShutdownTimeout), slog.String("error", err.Error()))
+		srv.Close()
+	}
 }
@@ -1,51 +1,195 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+	// Reports take any IANA time zone, the image may not ship a zoneinfo database
+	_ "time/tzdata"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/health"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/service"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/tracing"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/upstream"
 
-	"github.com/joho/godotenv"
-
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthCheckInterval = 10 * time.Second
+	healthCheckTimeout  = 3 * time.Second
 )
 
 func main() {
-	err := godotenv.Load(".env")
+	healthCheck := flag.Bool("healthcheck", false, "check the health of a running server and exit")
+	rebuildRollupsFrom := flag.String("rebuild-rollups-from", "", "rebuild the sales rollups from this UTC date, YYYY-MM-DD, up to now and exit")
+	flag.Parse()
+
+	// Load and validate the settings once, the server does not start with a broken configuration
+	settings, err := config.LoadServerSettings()
+	if err != nil {
+		log.Fatalf("failed to load settings: %v", err)
+	}
+	money.SetCurrency(settings.Currency)
+
+	if *healthCheck {
+		os.Exit(runHealthCheck(settings.Server.GrpcPort))
+	}
+
+	logger, err := logging.New(os.Stdout, settings.Log.Format, settings.Log.Level)
+	if err != nil {
+		log.Fatalf("failed to build the logger: %v", err)
+	}
+	slog.SetDefault(logger)
+
+	// SIGINT and SIGTERM start the shutdown, in-flight calls get settings.Server.ShutdownTimeout to finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Spans are flushed last, after every connection is closed
+	shutdownTracing, err := tracing.Setup(ctx, settings.Tracing, "sales-service")
 	if err != nil {
-		fmt.Println("Error is occurred  on .env file please check")
+		fatal(logger, "failed to set up tracing", err)
 	}
+	defer flushTraces(logger, shutdownTracing, settings.Server.ShutdownTimeout)
+
 	// Initialize the database
-	dbConfig := config.NewConfig()
-	rbConfig := config.NewRabbitMqCofig()
-	grpcConfig := config.NewGRPCConfig()
+	dbConfig, err := config.NewConfig(settings, logger)
+	if err != nil {
+		fatal(logger, "failed to connect to the database", err)
+	}
+	defer closeConnection(logger, "database", dbConfig.Close)
+
+	rbConfig, err := config.NewRabbitMqCofig(settings, logger)
+	if err != nil {
+		fatal(logger, "failed to connect to the message broker", err)
+	}
+	defer closeConnection(logger, "message broker", rbConfig.Close)
+
+	upstreamClient, err := upstream.NewClient(settings.Upstream)
+	if err != nil {
+		fatal(logger, "failed to connect to the upstream services", err)
+	}
+	defer closeConnection(logger, "upstream services", upstreamClient.Close)
+
+	// The permission table decides which roles reach which method before a service runs
+	permissions, err := policy.Load(settings)
+	if err != nil {
+		fatal(logger, "failed to load the permission policy", err)
+	}
+	// Roles of the callers are cached so the permission checks survive a slow or failing company service
+	roleCache := upstream.NewRoleCache(upstreamClient.GetPosRoleById, dbConfig.RedisDB, settings.RoleCache, logger)
 
 	// Initialize the repositories
-	cashDrawerRepo := repository.NewPosCashDrawerRepository(dbConfig.SQLDB, dbConfig.RedisDB)
-	customerRepo := repository.NewPosCustomerRepository(dbConfig.SQLDB, dbConfig.RedisDB)
-	invoiceRepo := repository.NewPosInvoiceRepository(dbConfig.SQLDB, dbConfig.RedisDB)
-	onlinePaymentRepo := repository.NewPosOnlinePaymentRepository(dbConfig.SQLDB, dbConfig.RedisDB)
-	paymentMethodRepo := repository.NewPosPaymentMethodRepository(dbConfig.SQLDB, dbConfig.RedisDB)
-	returnRepo := repository.NewPosReturnRepository(dbConfig.SQLDB, dbConfig.RedisDB)
-	saleRepo := repository.NewPosSaleRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	cashDrawerRepo := repository.NewPosCashDrawerRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	customerRepo := repository.NewPosCustomerRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	invoiceRepo := repository.NewPosInvoiceRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	onlinePaymentRepo := repository.NewPosOnlinePaymentRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	paymentMethodRepo := repository.NewPosPaymentMethodRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	returnRepo := repository.NewPosReturnRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	taxRateRepo := repository.NewPosTaxRateRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	promotionRuleRepo := repository.NewPosPromotionRuleRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	saleRepo := repository.NewPosSaleRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	receiptRepo := repository.NewPosReceiptRepository(dbConfig.SQLDB, dbConfig.RedisDB, settings.Roles)
+	checkoutRepo := repository.NewPosCheckoutRepository(dbConfig.SQLDB)
+	outboxRepo := repository.NewPosOutboxRepository(dbConfig.SQLDB)
+	drawerSessionRepo := repository.NewPosDrawerSessionRepository(dbConfig.SQLDB, settings.Roles)
+	idempotencyKeyRepo := repository.NewPosIdempotencyKeyRepository(dbConfig.SQLDB)
+	reportRepo := repository.NewPosReportRepository(dbConfig.SQLDB, settings.Payments, settings.Roles)
+
+	// The rollups only follow the sales written after they were added, older sales are rolled up on demand
+	if *rebuildRollupsFrom != "" {
+		if err := rebuildRollups(logger, reportRepo, *rebuildRollupsFrom); err != nil {
+			fatal(logger, "failed to rebuild the sales rollups", err)
+		}
+		return
+	}
 
 	// Initialize the services
-	cashDrawerSvc := service.NewPosCashDrawerServiceServer(cashDrawerRepo, grpcConfig.CompanyServiceConn)
-	customerSvc := service.NewPosCustomerService(customerRepo, grpcConfig.CompanyServiceConn)
-	invoiceSvc := service.NewPosInvoiceService(invoiceRepo, grpcConfig.CompanyServiceConn)
-	onlinePaymentSvc := service.NewPosOnlinePaymentService(onlinePaymentRepo, grpcConfig.CompanyServiceConn)
-	paymentMethodSvc := service.NewPosPaymentMethodService(paymentMethodRepo, grpcConfig.CompanyServiceConn)
-	returnSvc := service.NewPosReturnService(returnRepo, grpcConfig.CompanyServiceConn)
-	saleSvc := service.NewPosSaleService(saleRepo, invoiceRepo, cashDrawerRepo, onlinePaymentRepo, paymentMethodRepo, customerRepo, rbConfig.RabbitMQConn, grpcConfig.ProductServiceConn, grpcConfig.CompanyServiceConn)
+	cashDrawerSvc := service.NewPosCashDrawerServiceServer(cashDrawerRepo, drawerSessionRepo)
+	customerSvc := service.NewPosCustomerService(customerRepo)
+	invoiceSvc := service.NewPosInvoiceService(invoiceRepo)
+	onlinePaymentSvc := service.NewPosOnlinePaymentService(onlinePaymentRepo)
+	paymentMethodSvc := service.NewPosPaymentMethodService(paymentMethodRepo)
+	returnSvc := service.NewPosReturnService(returnRepo, receiptRepo, drawerSessionRepo, settings.Payments, upstreamClient)
+	taxRateSvc := service.NewPosTaxRateService(taxRateRepo)
+	promotionRuleSvc := service.NewPosPromotionRuleService(promotionRuleRepo)
+	// Checkouts retried with the same Idempotency-Key are answered with their first response
+	idempotencyKeys := service.NewIdempotencyKeys(idempotencyKeyRepo, settings.Idempotency, logger)
+	saleSvc := service.NewPosSaleService(saleRepo, idempotencyKeys, checkoutRepo, paymentMethodRepo, customerRepo, taxRateRepo, promotionRuleRepo, drawerSessionRepo, settings.Payments, upstreamClient, logger)
+	receiptSvc := service.NewPosReceiptService(receiptRepo, idempotencyKeys, checkoutRepo, paymentMethodRepo, customerRepo, taxRateRepo, promotionRuleRepo, drawerSessionRepo, settings.Payments, upstreamClient, logger)
+	reportSvc := service.NewPosReportService(reportRepo, upstreamClient)
+
+	// Publish the digital receipts and inventory events stored in the outbox
+	// The loops stop with ctx and are waited for before the connections they use are closed
+	var background sync.WaitGroup
+	outboxDispatcher := service.NewOutboxDispatcher(outboxRepo, rbConfig, logger)
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		outboxDispatcher.Run(ctx)
+	}()
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		idempotencyKeys.Run(ctx)
+	}()
 
 	// Create a gRPC server
-	s := grpc.NewServer()
+	// Every call gets a request id, taken from the gateway metadata when it sends one, is logged with it
+	// and continues the trace of the caller, health checks are left out of the traces. A panic of a handler
+	// is logged and answered with Internal, the logging and metrics see it as a failed call.
+	// The bearer token of the metadata is verified and the role of the caller checked against the permission policy
+	// before any service runs, services read the caller and its access from the context.
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck())))),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(logger),
+			metrics.UnaryServerInterceptor(),
+			logging.RecoveryUnaryServerInterceptor(logger),
+			auth.UnaryServerInterceptor(auth.NewVerifier(settings.Auth)),
+			policy.UnaryServerInterceptor(permissions, roleCache),
+		),
+	)
+
+	// Report the health of the server and of every dependency
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	healthChecker := health.NewChecker(healthServer, healthCheckInterval, healthCheckTimeout, logger,
+		health.PostgresProbe(dbConfig.SQLDB),
+		health.RedisProbe(dbConfig.RedisDB),
+		health.RabbitMQProbe(rbConfig),
+		health.GrpcConnProbe(health.PRODUCT_SERVICE, upstreamClient.ProductConn()),
+		health.GrpcConnProbe(health.COMPANY_SERVICE, upstreamClient.CompanyConn()),
+	)
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		healthChecker.Run(ctx)
+	}()
 
 	// Register the services with the gRPC server
 	pb.RegisterPosCashDrawerServiceServer(s, cashDrawerSvc)
@@ -54,15 +198,128 @@ func main() {
 	pb.RegisterPosOnlinePaymentServiceServer(s, onlinePaymentSvc)
 	pb.RegisterPosPaymentMethodServiceServer(s, paymentMethodSvc)
 	pb.RegisterPosReturnServiceServer(s, returnSvc)
+	pb.RegisterPosTaxRateServiceServer(s, taxRateSvc)
+	pb.RegisterPosPromotionRuleServiceServer(s, promotionRuleSvc)
 	pb.RegisterPosSaleServiceServer(s, saleSvc)
+	pb.RegisterPosReceiptServiceServer(s, receiptSvc)
+	pb.RegisterPosReportServiceServer(s, reportSvc)
+
+	// Serve /metrics on its own HTTP port, the gRPC port only speaks gRPC
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:    ":" + settings.Server.MetricsPort,
+		Handler: metricsMux,
+	}
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("failed to serve metrics", slog.String("error", err.Error()))
+		}
+	}()
 
 	// Start the gRPC server
-	serverPort := os.Getenv("SERVER_PORT")
-	lis, err := net.Listen("tcp", ":"+serverPort)
+	lis, err := net.Listen("tcp", ":"+settings.Server.GrpcPort)
+	if err != nil {
+		fatal(logger, "failed to listen", err)
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		logger.Error("failed to serve", slog.String("error", err.Error()))
+		stop()
+	case <-ctx.Done():
+		logger.Info("shutting down the gRPC server")
+	}
+
+	// Stop reporting SERVING first so the gateway and the orchestrator stop sending new calls
+	healthServer.Shutdown()
+	gracefulStop(s, settings.Server.ShutdownTimeout, logger)
+	background.Wait()
+
+	// Metrics stay available until the last call is done
+	metricsCtx, cancel := context.WithTimeout(context.Background(), settings.Server.ShutdownTimeout)
+	defer cancel()
+	if err := metricsServer.Shutdown(metricsCtx); err != nil {
+		logger.Warn("failed to shut down the metrics server", slog.String("error", err.Error()))
+	}
+}
+
+// gracefulStop lets in-flight calls finish and cuts them when the timeout is reached
+func gracefulStop(s *grpc.Server, timeout time.Duration, logger *slog.Logger) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		logger.Warn("in-flight calls did not finish in time, stopping the gRPC server", slog.Duration("timeout", timeout))
+		s.Stop()
+	}
+}
+
+func closeConnection(logger *slog.Logger, name string, close func() error) {
+	if err := close(); err != nil {
+		logger.Error("failed to close the "+name, slog.String("error", err.Error()))
+	}
+}
+
+func flushTraces(logger *slog.Logger, shutdown func(context.Context) error, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := shutdown(ctx); err != nil {
+		logger.Warn("failed to flush the traces", slog.String("error", err.Error()))
+	}
+}
+
+// fatal logs the startup failure and exits, the deferred closes do not run
+func fatal(logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, slog.String("error", err.Error()))
+	os.Exit(1)
+}
+
+// rebuildRollups computes the sales rollups from the start of the given UTC date up to the current hour
+func rebuildRollups(logger *slog.Logger, reportRepo repository.PosReportRepository, from string) error {
+	fromDate, err := time.Parse(time.DateOnly, from)
+	if err != nil {
+		return fmt.Errorf("rebuild-rollups-from must be YYYY-MM-DD: %w", err)
+	}
+
+	written, err := reportRepo.RebuildPosSalesRollups(fromDate, time.Now())
+	if err != nil {
+		return err
+	}
+
+	logger.Info("rebuilt the sales rollups", slog.String("from", from), slog.Int("rollups", written))
+	return nil
+}
+
+// runHealthCheck asks the server on the local port for its overall health, the exit code is 0 only when it is serving
+func runHealthCheck(port string) int {
+	conn, err := grpc.NewClient("localhost:"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Printf("failed to connect to the server: %v", err)
+		return 1
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		log.Printf("health check failed: %v", err)
+		return 1
 	}
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		log.Printf("server is %s", resp.GetStatus())
+		return 1
 	}
+	return 0
 }
@@ -2,9 +2,10 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
@@ -12,8 +13,6 @@ import (
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/streadway/amqp"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Config struct {
@@ -21,109 +20,180 @@ type Config struct {
 	RedisDB *redis.Client
 }
 
-type GrpcClientConfig struct {
-	ProductServiceConn *grpc.ClientConn
-	CompanyServiceConn *grpc.ClientConn
-}
-
 type RabbitMqConfig struct {
 	RabbitMQConn *amqp.Connection
+	url          string
+	closed       bool
+	mu           sync.Mutex
 }
 
-func connectPostgres() *gorm.DB {
-	host := os.Getenv("SQL_HOST")
-	port, _ := strconv.Atoi(os.Getenv("SQL_PORT"))
-	user := os.Getenv("SQL_USER")
-	dbname := os.Getenv("SQL_DB_NAME")
-	pass := os.Getenv("SQL_PASSWORD")
+// listIndexes are the indexes the paged lists read through, the scope column of the role, then the order of the page and its key.
+// Token pages are ordered by created_at, numbered pages by the date of the record.
+var listIndexes = []struct {
+	table  string
+	key    string
+	scopes []string
+	orders []string
+}{
+	{"pos_cash_drawer", "drawer_id", []string{"company_id", "branch_id", "store_id"}, []string{"created_at", "transaction_time"}},
+	{"pos_drawer_sessions", "session_id", []string{"company_id", "branch_id"}, []string{"created_at", "opened_at"}},
+	{"pos_customers", "customer_id", []string{"company_id", "branch_id"}, []string{"created_at", "registration_date"}},
+	{"pos_invoices", "invoice_id", []string{"company_id", "branch_id"}, []string{"created_at", "date"}},
+	{"pos_online_payments", "payment_id", []string{"company_id", "branch_id", "store_id"}, []string{"created_at", "payment_date"}},
+	{"pos_payment_methods", "payment_method_id", []string{"company_id"}, []string{"created_at"}},
+	{"pos_returns", "return_id", []string{"company_id", "branch_id", "store_id"}, []string{"created_at", "return_date"}},
+	{"pos_sales", "sale_id", []string{"company_id", "branch_id", "store_id"}, []string{"created_at", "sale_date"}},
+	{"pos_receipts", "pos_receipt_id", []string{"company_id", "branch_id", "store_id"}, []string{"created_at", "receipt_date"}},
+	{"pos_tax_rates", "tax_rate_id", []string{"company_id"}, []string{"created_at"}},
+	{"pos_promotion_rules", "promotion_rule_id", []string{"company_id"}, []string{"created_at"}},
+}
 
+func connectPostgres(settings PostgresSettings, logger *slog.Logger) (*gorm.DB, error) {
 	psqlSetup := fmt.Sprintf("host=%s port=%d user=%s dbname=%s password=%s sslmode=disable",
-		host, port, user, dbname, pass)
+		settings.Host, settings.Port, settings.User, settings.DBName, settings.Password)
 
 	sqlDB, err := gorm.Open("postgres", psqlSetup)
-
 	if err != nil {
-		fmt.Println("Failed to connect to PostgreSQL:", err)
-		return nil
-	} else {
-		fmt.Println("Successfully connected to PostgreSQL")
-		sqlDB.AutoMigrate(entity.PosCashDrawer{}, entity.PosInvoice{}, entity.PosOnlinePayment{}, entity.PosPaymentMethod{}, entity.PosReturn{}, entity.PosSale{}, entity.PosCustomer{})
-		return sqlDB
+		return nil, fmt.Errorf("failed to connect to PostgreSQL: %w", err)
 	}
+
+	logger.Info("connected to PostgreSQL")
+	if err := sqlDB.AutoMigrate(entity.PosCashDrawer{}, entity.PosInvoice{}, entity.PosOnlinePayment{}, entity.PosPaymentMethod{}, entity.PosReturn{}, entity.PosSale{}, entity.PosCustomer{}, entity.PosOutboxMessage{}, entity.PosReceipt{}, entity.PosReceiptLine{}, entity.PosReceiptTender{}, entity.PosTaxRate{}, entity.PosPromotionRule{}, entity.PosDrawerSession{}, entity.PosDrawerSessionDenomination{}, entity.PosIdempotencyKey{}, entity.PosSalesRollup{}).Error; err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to migrate PostgreSQL: %w", err)
+	}
+	// AutoMigrate cannot create partial indexes, a cashier can only have one open session per store
+	if err := sqlDB.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_pos_drawer_sessions_open ON pos_drawer_sessions (store_id, cashier_id) WHERE status = 'OPEN'").Error; err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to migrate PostgreSQL: %w", err)
+	}
+	// AutoMigrate orders the columns of a composite index by the struct, the scope has to lead
+	for _, index := range listIndexes {
+		for _, scope := range index.scopes {
+			for _, order := range index.orders {
+				name := fmt.Sprintf("idx_%s_%s_%s", index.table, scope, order)
+				statement := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (%s, "%s", %s)`, name, index.table, scope, order, index.key)
+				if err := sqlDB.Exec(statement).Error; err != nil {
+					sqlDB.Close()
+					return nil, fmt.Errorf("failed to migrate PostgreSQL: %w", err)
+				}
+			}
+		}
+	}
+	return sqlDB, nil
 }
 
-func connectRedis() *redis.Client {
+func connectRedis(settings RedisSettings, logger *slog.Logger) (*redis.Client, error) {
 	redisDB := redis.NewClient(&redis.Options{
-		Addr: os.Getenv("REDIS_ADDR"),
-		// Password: os.Getenv("REDIS_PASSWORD"),
-		DB: 0,
+		Addr:     settings.Addr,
+		Password: settings.Password,
+		DB:       0,
 	})
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	_, err := redisDB.Ping(ctx).Result()
-	if err != nil {
-		fmt.Println("Failed to connect to Redis:", err)
-		return nil
-	} else {
-		fmt.Println("Successfully connected to Redis")
-		return redisDB
+	if _, err := redisDB.Ping(ctx).Result(); err != nil {
+		redisDB.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
 	}
+
+	logger.Info("connected to Redis")
+	return redisDB, nil
 }
 
-func connectRabbitMQ() *amqp.Connection {
-	conn, err := amqp.Dial(os.Getenv("RABBITMQ_URL"))
+func connectRabbitMQ(settings RabbitMQSettings, logger *slog.Logger) (*amqp.Connection, error) {
+	conn, err := amqp.Dial(settings.URL)
 	if err != nil {
-		fmt.Println("Failed to connect to RabbitMQ:", err)
-		return nil
-	} else {
-		fmt.Println("Successfully connected to RabbitMQ")
-		return conn
+		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
 	}
+
+	logger.Info("connected to RabbitMQ")
+	return conn, nil
 }
 
-func connectProductServiceGRPC() *grpc.ClientConn {
-	productGrpcServicePort := os.Getenv("PRODUCT_GRPC")
-	addr := fmt.Sprintf("localhost:%s", productGrpcServicePort)
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewConfig connects to PostgreSQL and Redis, it fails when either of them cannot be reached
+func NewConfig(settings *Settings, logger *slog.Logger) (*Config, error) {
+	sqlDB, err := connectPostgres(settings.Postgres, logger)
 	if err != nil {
-		fmt.Println("Failed to connect to Product Service gRPC:", err)
-		return nil
-	} else {
-		fmt.Println("Successfully connected to Product Service gRPC")
-		return conn
+		return nil, err
 	}
-}
 
-func connectCompanyServiceGRPC() *grpc.ClientConn {
-	companyGrpcServicePort := os.Getenv("COMPANY_GRPC")
-	addr := fmt.Sprintf("localhost:%s", companyGrpcServicePort)
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	redisDB, err := connectRedis(settings.Redis, logger)
 	if err != nil {
-		fmt.Println("Failed to connect to Company Service gRPC:", err)
-		return nil
-	} else {
-		fmt.Println("Successfully connected to Company Service gRPC")
-		return conn
+		sqlDB.Close()
+		return nil, err
 	}
-}
 
-func NewConfig() *Config {
 	return &Config{
-		SQLDB:   connectPostgres(),
-		RedisDB: connectRedis(),
+		SQLDB:   sqlDB,
+		RedisDB: redisDB,
+	}, nil
+}
+
+// Close closes Redis and then PostgreSQL, it returns every error it meets
+func (c *Config) Close() error {
+	var errs []error
+
+	if c.RedisDB != nil {
+		if err := c.RedisDB.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close Redis: %w", err))
+		}
+	}
+
+	if c.SQLDB != nil {
+		if err := c.SQLDB.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close PostgreSQL: %w", err))
+		}
 	}
+
+	return errors.Join(errs...)
 }
 
-func NewRabbitMqCofig() *RabbitMqConfig {
+func NewRabbitMqCofig(settings *Settings, logger *slog.Logger) (*RabbitMqConfig, error) {
+	conn, err := connectRabbitMQ(settings.RabbitMQ, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &RabbitMqConfig{
-		RabbitMQConn: connectRabbitMQ(),
+		RabbitMQConn: conn,
+		url:          settings.RabbitMQ.URL,
+	}, nil
+}
+
+// Connection returns the current RabbitMQ connection, dialing again when it has been closed
+func (c *RabbitMqConfig) Connection() (*amqp.Connection, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, errors.New("rabbitmq connection has been shut down")
 	}
+
+	if c.RabbitMQConn != nil && !c.RabbitMQConn.IsClosed() {
+		return c.RabbitMQConn, nil
+	}
+
+	conn, err := amqp.Dial(c.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+	c.RabbitMQConn = conn
+	return conn, nil
 }
 
-func NewGRPCConfig() *GrpcClientConfig {
-	return &GrpcClientConfig{
-		ProductServiceConn: connectProductServiceGRPC(),
-		CompanyServiceConn: connectCompanyServiceGRPC(),
+// Close closes the RabbitMQ connection, Connection does not dial again afterwards
+func (c *RabbitMqConfig) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	if c.RabbitMQConn == nil || c.RabbitMQConn.IsClosed() {
+		return nil
+	}
+
+	if err := c.RabbitMQConn.Close(); err != nil {
+		return fmt.Errorf("failed to close RabbitMQ: %w", err)
 	}
+	return nil
 }
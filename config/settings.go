@@ -0,0 +1,555 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// DEFAULT_SHUTDOWN_TIMEOUT is used when the settings do not set server.shutdown_timeout
+const DEFAULT_SHUTDOWN_TIMEOUT = 30 * time.Second
+
+// DEFAULT_METRICS_PORT is used when the settings do not set server.metrics_port
+const DEFAULT_METRICS_PORT = "9464"
+
+// Defaults of the role lookups at the company service
+const (
+	DEFAULT_ROLE_CACHE_TTL       = 5 * time.Minute
+	DEFAULT_ROLE_CACHE_STALE_TTL = 24 * time.Hour
+	DEFAULT_ROLE_LOOKUP_TIMEOUT  = 2 * time.Second
+	DEFAULT_ROLE_LOOKUP_ATTEMPTS = 3
+	DEFAULT_ROLE_LOOKUP_BACKOFF  = 100 * time.Millisecond
+)
+
+// Defaults of the idempotency keys sent with the checkouts
+const (
+	DEFAULT_IDEMPOTENCY_KEY_TTL      = 24 * time.Hour
+	DEFAULT_IDEMPOTENCY_LOCK_TIMEOUT = 2 * time.Minute
+)
+
+// Settings is the typed configuration of both binaries.
+// It is read once at startup from an optional YAML file (CONFIG_FILE) and the environment, the environment wins.
+type Settings struct {
+	Server      ServerSettings      `yaml:"server"`
+	Postgres    PostgresSettings    `yaml:"postgres"`
+	Redis       RedisSettings       `yaml:"redis"`
+	RabbitMQ    RabbitMQSettings    `yaml:"rabbitmq"`
+	Upstream    UpstreamSettings    `yaml:"upstream"`
+	Auth        AuthSettings        `yaml:"auth"`
+	Roles       RoleSettings        `yaml:"roles"`
+	Payments    PaymentSettings     `yaml:"payments"`
+	Currency    string              `yaml:"currency_code"`
+	Log         LogSettings         `yaml:"log"`
+	Tracing     TracingSettings     `yaml:"tracing"`
+	Policy      PolicySettings      `yaml:"policy"`
+	RoleCache   RoleCacheSettings   `yaml:"role_cache"`
+	Idempotency IdempotencySettings `yaml:"idempotency"`
+}
+
+// IdempotencySettings tunes the Idempotency-Key handling of the checkouts.
+// A completed checkout is replayed for TTL, a key whose checkout has not finished after LockTimeout
+// is taken to be abandoned by a crashed replica and may be used again.
+type IdempotencySettings struct {
+	TTL         time.Duration `yaml:"ttl"`
+	LockTimeout time.Duration `yaml:"lock_timeout"`
+}
+
+// RoleCacheSettings tunes the role lookups of the permission checks.
+// Roles are cached in memory and in Redis for TTL, when the company service cannot be reached
+// a cached role is still served until it is StaleTTL old. Every attempt is bounded by Timeout
+// and the deadline of the request, failed attempts are retried up to Attempts with a backoff doubling from Backoff.
+type RoleCacheSettings struct {
+	TTL      time.Duration `yaml:"ttl"`
+	StaleTTL time.Duration `yaml:"stale_ttl"`
+	Timeout  time.Duration `yaml:"timeout"`
+	Attempts int           `yaml:"attempts"`
+	Backoff  time.Duration `yaml:"backoff"`
+}
+
+// PolicySettings overrides entries of the default permission table of the gRPC server.
+// Rules are keyed by full method name, e.g. /pos.PosInvoiceService/ReadAllPosInvoices, and map every
+// allowed role (super_user, company_user, branch_user, store_user) to its scope (any, company, branch, store, owner).
+// A rule replaces the default of its method as a whole, File is read first and Rules win over it.
+type PolicySettings struct {
+	File  string                       `yaml:"file"`
+	Rules map[string]map[string]string `yaml:"rules"`
+}
+
+// TracingSettings selects where the spans go: "otlp" sends them to an OTLP/gRPC collector,
+// "stdout" prints them for local runs and "none" turns tracing off
+type TracingSettings struct {
+	Exporter    string  `yaml:"exporter"`
+	Endpoint    string  `yaml:"endpoint"`
+	Insecure    bool    `yaml:"insecure"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// LogSettings selects the level (debug, info, warn, error) and format (json, text) of the logs
+type LogSettings struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+type ServerSettings struct {
+	GrpcPort string `yaml:"grpc_port"`
+	HttpPort string `yaml:"http_port"`
+	// MetricsPort is the HTTP port of /metrics on the gRPC server, the gateway serves /metrics on HttpPort
+	MetricsPort string `yaml:"metrics_port"`
+	// ShutdownTimeout is how long in-flight calls may run after SIGTERM before they are cut
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+type PostgresSettings struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	DBName   string `yaml:"db_name"`
+}
+
+type RedisSettings struct {
+	Addr     string `yaml:"addr"`
+	Password string `yaml:"password"`
+}
+
+type RabbitMQSettings struct {
+	URL string `yaml:"url"`
+}
+
+// UpstreamSettings is the registry of the services this service talks to
+type UpstreamSettings struct {
+	Company UpstreamService `yaml:"company"`
+	Product UpstreamService `yaml:"product"`
+	// Sales is the gRPC server of this service, dialed by the HTTP gateway
+	Sales UpstreamService `yaml:"sales"`
+}
+
+// UpstreamService holds the endpoints of one service.
+// HTTP calls are spread round robin over the base URLs, gRPC targets use the gRPC name syntax,
+// e.g. dns:///company-service:50051, and are balanced round robin over every resolved address.
+type UpstreamService struct {
+	BaseURLs   []string    `yaml:"base_urls"`
+	GrpcTarget string      `yaml:"grpc_target"`
+	TLS        TLSSettings `yaml:"tls"`
+}
+
+type TLSSettings struct {
+	Enabled            bool   `yaml:"enabled"`
+	CAFile             string `yaml:"ca_file"`
+	ServerName         string `yaml:"server_name"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+type AuthSettings struct {
+	SecretKey string `yaml:"secret_key"`
+	Issuer    string `yaml:"issuer"`
+}
+
+// RoleSettings holds the role names the company service gives to every user level
+type RoleSettings struct {
+	SuperUserRole   string `yaml:"super_user"`
+	CompanyUserRole string `yaml:"company_user"`
+	BranchUserRole  string `yaml:"branch_user"`
+	StoreUserRole   string `yaml:"store_user"`
+}
+
+// PaymentSettings holds the names of the payment methods with their own bookkeeping
+type PaymentSettings struct {
+	CashMethod     string `yaml:"cash_method"`
+	PayLaterMethod string `yaml:"pay_later_method"`
+}
+
+// LoadServerSettings loads the settings of cmd/server and checks everything the gRPC server needs
+func LoadServerSettings() (*Settings, error) {
+	settings, err := loadSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := settings.validateServer(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// LoadGatewaySettings loads the settings of cmd/client and checks everything the HTTP gateway needs
+func LoadGatewaySettings() (*Settings, error) {
+	settings, err := loadSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := settings.validateGateway(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func loadSettings() (*Settings, error) {
+	// The .env file is optional, in containers the variables come from the environment
+	if err := godotenv.Load(".env"); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read .env: %w", err)
+	}
+
+	settings := &Settings{}
+
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+		}
+		if err := yaml.Unmarshal(content, settings); err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+	}
+
+	if err := settings.applyEnv(); err != nil {
+		return nil, err
+	}
+
+	if settings.Currency == "" {
+		settings.Currency = money.DEFAULT_CURRENCY
+	}
+
+	if settings.Log.Level == "" {
+		settings.Log.Level = "info"
+	}
+	if settings.Log.Format == "" {
+		settings.Log.Format = "json"
+	}
+
+	if settings.Tracing.Exporter == "" {
+		settings.Tracing.Exporter = "none"
+	}
+	if settings.Tracing.SampleRatio == 0 {
+		settings.Tracing.SampleRatio = 1
+	}
+
+	if settings.Server.MetricsPort == "" {
+		settings.Server.MetricsPort = DEFAULT_METRICS_PORT
+	}
+
+	if settings.Server.ShutdownTimeout <= 0 {
+		settings.Server.ShutdownTimeout = DEFAULT_SHUTDOWN_TIMEOUT
+	}
+
+	settings.RoleCache.applyDefaults()
+
+	if settings.Idempotency.TTL <= 0 {
+		settings.Idempotency.TTL = DEFAULT_IDEMPOTENCY_KEY_TTL
+	}
+	if settings.Idempotency.LockTimeout <= 0 {
+		settings.Idempotency.LockTimeout = DEFAULT_IDEMPOTENCY_LOCK_TIMEOUT
+	}
+
+	return settings, nil
+}
+
+func (s *Settings) applyEnv() error {
+	setFromEnv(&s.Server.GrpcPort, "SERVER_PORT")
+	setFromEnv(&s.Server.HttpPort, "CLIENT_PORT")
+	setFromEnv(&s.Server.MetricsPort, "METRICS_PORT")
+	if err := durationFromEnv(&s.Server.ShutdownTimeout, "SHUTDOWN_TIMEOUT"); err != nil {
+		return err
+	}
+
+	setFromEnv(&s.Postgres.Host, "SQL_HOST")
+	setFromEnv(&s.Postgres.User, "SQL_USER")
+	setFromEnv(&s.Postgres.Password, "SQL_PASSWORD")
+	setFromEnv(&s.Postgres.DBName, "SQL_DB_NAME")
+	if port := os.Getenv("SQL_PORT"); port != "" {
+		value, err := strconv.Atoi(port)
+		if err != nil {
+			return fmt.Errorf("SQL_PORT must be a number, got %q", port)
+		}
+		s.Postgres.Port = value
+	}
+
+	setFromEnv(&s.Redis.Addr, "REDIS_ADDR")
+	setFromEnv(&s.Redis.Password, "REDIS_PASSWORD")
+
+	setFromEnv(&s.RabbitMQ.URL, "RABBITMQ_URL")
+
+	setFromEnv(&s.Log.Level, "LOG_LEVEL")
+	setFromEnv(&s.Log.Format, "LOG_FORMAT")
+
+	if err := s.Tracing.applyEnv(); err != nil {
+		return err
+	}
+
+	for prefix, upstream := range map[string]*UpstreamService{"COMPANY": &s.Upstream.Company, "PRODUCT": &s.Upstream.Product, "SALES": &s.Upstream.Sales} {
+		if err := upstream.applyEnv(prefix); err != nil {
+			return err
+		}
+	}
+
+	// The old PORT only variables still point at a service on localhost
+	if port := os.Getenv("COMPANY_GRPC"); port != "" && s.Upstream.Company.GrpcTarget == "" {
+		s.Upstream.Company.GrpcTarget = "localhost:" + port
+	}
+	if port := os.Getenv("PRODUCT_GRPC"); port != "" && s.Upstream.Product.GrpcTarget == "" {
+		s.Upstream.Product.GrpcTarget = "localhost:" + port
+	}
+	if s.Upstream.Sales.GrpcTarget == "" && s.Server.GrpcPort != "" {
+		s.Upstream.Sales.GrpcTarget = "localhost:" + s.Server.GrpcPort
+	}
+
+	setFromEnv(&s.Auth.SecretKey, "SECRET_KEY")
+	setFromEnv(&s.Auth.Issuer, "ISSUER")
+
+	setFromEnv(&s.Roles.SuperUserRole, "SUPER_USER_ROLE")
+	setFromEnv(&s.Roles.CompanyUserRole, "COMPANY_USER_ROLE")
+	setFromEnv(&s.Roles.BranchUserRole, "BRANCH_USER_ROLE")
+	setFromEnv(&s.Roles.StoreUserRole, "STORE_USER_ROLE")
+
+	setFromEnv(&s.Policy.File, "POLICY_FILE")
+
+	if err := s.RoleCache.applyEnv(); err != nil {
+		return err
+	}
+
+	if err := durationFromEnv(&s.Idempotency.TTL, "IDEMPOTENCY_KEY_TTL"); err != nil {
+		return err
+	}
+	if err := durationFromEnv(&s.Idempotency.LockTimeout, "IDEMPOTENCY_LOCK_TIMEOUT"); err != nil {
+		return err
+	}
+
+	setFromEnv(&s.Payments.CashMethod, "CASH_METHOD")
+	setFromEnv(&s.Payments.PayLaterMethod, "PAY_LATER_METHOD")
+
+	setFromEnv(&s.Currency, "CURRENCY_CODE")
+
+	return nil
+}
+
+// applyEnv reads <PREFIX>_HTTP_URLS (comma separated), <PREFIX>_GRPC_TARGET and the <PREFIX>_TLS_* variables
+func (u *UpstreamService) applyEnv(prefix string) error {
+	if urls := os.Getenv(prefix + "_HTTP_URLS"); urls != "" {
+		u.BaseURLs = nil
+		for _, baseURL := range strings.Split(urls, ",") {
+			if baseURL = strings.TrimSpace(baseURL); baseURL != "" {
+				u.BaseURLs = append(u.BaseURLs, baseURL)
+			}
+		}
+	}
+
+	setFromEnv(&u.GrpcTarget, prefix+"_GRPC_TARGET")
+	setFromEnv(&u.TLS.CAFile, prefix+"_TLS_CA_FILE")
+	setFromEnv(&u.TLS.ServerName, prefix+"_TLS_SERVER_NAME")
+
+	for key, target := range map[string]*bool{prefix + "_TLS": &u.TLS.Enabled, prefix + "_TLS_INSECURE_SKIP_VERIFY": &u.TLS.InsecureSkipVerify} {
+		if value := os.Getenv(key); value != "" {
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("%s must be true or false, got %q", key, value)
+			}
+			*target = enabled
+		}
+	}
+
+	return nil
+}
+
+// applyEnv reads the standard OpenTelemetry variables of the exporter, its endpoint and the sample ratio
+func (t *TracingSettings) applyEnv() error {
+	setFromEnv(&t.Exporter, "OTEL_TRACES_EXPORTER")
+	setFromEnv(&t.Endpoint, "OTEL_EXPORTER_OTLP_ENDPOINT")
+
+	if value := os.Getenv("OTEL_EXPORTER_OTLP_INSECURE"); value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("OTEL_EXPORTER_OTLP_INSECURE must be true or false, got %q", value)
+		}
+		t.Insecure = insecure
+	}
+
+	if value := os.Getenv("OTEL_TRACES_SAMPLER_ARG"); value != "" {
+		ratio, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("OTEL_TRACES_SAMPLER_ARG must be a number, got %q", value)
+		}
+		t.SampleRatio = ratio
+	}
+
+	return nil
+}
+
+// applyEnv reads ROLE_CACHE_TTL, ROLE_CACHE_STALE_TTL, ROLE_LOOKUP_TIMEOUT, ROLE_LOOKUP_ATTEMPTS and ROLE_LOOKUP_BACKOFF
+func (r *RoleCacheSettings) applyEnv() error {
+	for key, target := range map[string]*time.Duration{
+		"ROLE_CACHE_TTL":       &r.TTL,
+		"ROLE_CACHE_STALE_TTL": &r.StaleTTL,
+		"ROLE_LOOKUP_TIMEOUT":  &r.Timeout,
+		"ROLE_LOOKUP_BACKOFF":  &r.Backoff,
+	} {
+		if err := durationFromEnv(target, key); err != nil {
+			return err
+		}
+	}
+
+	if value := os.Getenv("ROLE_LOOKUP_ATTEMPTS"); value != "" {
+		attempts, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("ROLE_LOOKUP_ATTEMPTS must be a number, got %q", value)
+		}
+		r.Attempts = attempts
+	}
+
+	return nil
+}
+
+func (r *RoleCacheSettings) applyDefaults() {
+	if r.TTL <= 0 {
+		r.TTL = DEFAULT_ROLE_CACHE_TTL
+	}
+	if r.StaleTTL <= 0 {
+		r.StaleTTL = DEFAULT_ROLE_CACHE_STALE_TTL
+	}
+	if r.Timeout <= 0 {
+		r.Timeout = DEFAULT_ROLE_LOOKUP_TIMEOUT
+	}
+	if r.Attempts <= 0 {
+		r.Attempts = DEFAULT_ROLE_LOOKUP_ATTEMPTS
+	}
+	if r.Backoff <= 0 {
+		r.Backoff = DEFAULT_ROLE_LOOKUP_BACKOFF
+	}
+}
+
+func (r RoleCacheSettings) validate(problems *[]error) {
+	if r.StaleTTL < r.TTL {
+		*problems = append(*problems, fmt.Errorf("role_cache.stale_ttl (ROLE_CACHE_STALE_TTL) must not be shorter than role_cache.ttl (ROLE_CACHE_TTL), got %s and %s", r.StaleTTL, r.TTL))
+	}
+}
+
+func durationFromEnv(target *time.Duration, key string) error {
+	if value := os.Getenv(key); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%s must be a duration like 30s, got %q", key, value)
+		}
+		*target = duration
+	}
+	return nil
+}
+
+func setFromEnv(target *string, key string) {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		*target = value
+	}
+}
+
+// validateServer reports every missing or invalid setting at once so a broken deployment is fixed in one go
+func (s *Settings) validateServer() error {
+	var problems []error
+
+	require(&problems, s.Server.GrpcPort, "server.grpc_port (SERVER_PORT)")
+	require(&problems, s.Postgres.Host, "postgres.host (SQL_HOST)")
+	require(&problems, s.Postgres.User, "postgres.user (SQL_USER)")
+	require(&problems, s.Postgres.DBName, "postgres.db_name (SQL_DB_NAME)")
+	if s.Postgres.Port <= 0 || s.Postgres.Port > 65535 {
+		problems = append(problems, fmt.Errorf("postgres.port (SQL_PORT) must be between 1 and 65535, got %d", s.Postgres.Port))
+	}
+	require(&problems, s.Redis.Addr, "redis.addr (REDIS_ADDR)")
+	require(&problems, s.RabbitMQ.URL, "rabbitmq.url (RABBITMQ_URL)")
+	s.Upstream.Company.validate(&problems, "company", "COMPANY", true)
+	s.Upstream.Product.validate(&problems, "product", "PRODUCT", true)
+	require(&problems, s.Payments.CashMethod, "payments.cash_method (CASH_METHOD)")
+	require(&problems, s.Payments.PayLaterMethod, "payments.pay_later_method (PAY_LATER_METHOD)")
+	if len(s.Currency) != 3 {
+		problems = append(problems, fmt.Errorf("currency_code (CURRENCY_CODE) must be a 3 letter ISO 4217 code, got %q", s.Currency))
+	}
+	require(&problems, s.Auth.SecretKey, "auth.secret_key (SECRET_KEY)")
+	require(&problems, s.Auth.Issuer, "auth.issuer (ISSUER)")
+	s.Roles.validate(&problems)
+	s.RoleCache.validate(&problems)
+	if s.Idempotency.LockTimeout > s.Idempotency.TTL {
+		problems = append(problems, fmt.Errorf("idempotency.lock_timeout (IDEMPOTENCY_LOCK_TIMEOUT) must not be longer than idempotency.ttl (IDEMPOTENCY_KEY_TTL), got %s and %s", s.Idempotency.LockTimeout, s.Idempotency.TTL))
+	}
+	s.Tracing.validate(&problems)
+	if s.Policy.File != "" {
+		if _, err := os.Stat(s.Policy.File); err != nil {
+			problems = append(problems, fmt.Errorf("policy.file (POLICY_FILE) cannot be read: %v", err))
+		}
+	}
+
+	return settingsError(problems)
+}
+
+func (s *Settings) validateGateway() error {
+	var problems []error
+
+	require(&problems, s.Server.HttpPort, "server.http_port (CLIENT_PORT)")
+	s.Upstream.Sales.validate(&problems, "sales", "SALES", false)
+	require(&problems, s.Auth.SecretKey, "auth.secret_key (SECRET_KEY)")
+	require(&problems, s.Auth.Issuer, "auth.issuer (ISSUER)")
+	s.Tracing.validate(&problems)
+
+	return settingsError(problems)
+}
+
+func (t TracingSettings) validate(problems *[]error) {
+	switch t.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		require(problems, t.Endpoint, "tracing.endpoint (OTEL_EXPORTER_OTLP_ENDPOINT)")
+	default:
+		*problems = append(*problems, fmt.Errorf("tracing.exporter (OTEL_TRACES_EXPORTER) must be otlp, stdout or none, got %q", t.Exporter))
+	}
+
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		*problems = append(*problems, fmt.Errorf("tracing.sample_ratio (OTEL_TRACES_SAMPLER_ARG) must be between 0 and 1, got %v", t.SampleRatio))
+	}
+}
+
+func (r RoleSettings) validate(problems *[]error) {
+	require(problems, r.SuperUserRole, "roles.super_user (SUPER_USER_ROLE)")
+	require(problems, r.CompanyUserRole, "roles.company_user (COMPANY_USER_ROLE)")
+	require(problems, r.BranchUserRole, "roles.branch_user (BRANCH_USER_ROLE)")
+	require(problems, r.StoreUserRole, "roles.store_user (STORE_USER_ROLE)")
+}
+
+func (u UpstreamService) validate(problems *[]error, name string, prefix string, needsHTTP bool) {
+	require(problems, u.GrpcTarget, fmt.Sprintf("upstream.%s.grpc_target (%s_GRPC_TARGET)", name, prefix))
+
+	if needsHTTP && len(u.BaseURLs) == 0 {
+		*problems = append(*problems, fmt.Errorf("upstream.%s.base_urls (%s_HTTP_URLS) is required", name, prefix))
+	}
+
+	for _, baseURL := range u.BaseURLs {
+		parsed, err := url.Parse(baseURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			*problems = append(*problems, fmt.Errorf("upstream.%s.base_urls (%s_HTTP_URLS) has an invalid url %q", name, prefix, baseURL))
+		}
+	}
+
+	if u.TLS.CAFile != "" {
+		if _, err := os.Stat(u.TLS.CAFile); err != nil {
+			*problems = append(*problems, fmt.Errorf("upstream.%s.tls.ca_file (%s_TLS_CA_FILE) cannot be read: %v", name, prefix, err))
+		}
+	}
+}
+
+func require(problems *[]error, value string, name string) {
+	if value == "" {
+		*problems = append(*problems, fmt.Errorf("%s is required", name))
+	}
+}
+
+func settingsError(problems []error) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid settings: %w", errors.Join(problems...))
+}
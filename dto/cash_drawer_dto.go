@@ -8,6 +8,10 @@ const (
 	MESSAGE_FAILED_UPDATE_CASH_DRAWER = "failed to update cash drawer"
 	MESSAGE_FAILED_DELETE_CASH_DRAWER = "failed to delete cash drawer"
 	MESSAGE_FAILED_GET_CASH_DRAWER    = "failed to get cash drawer"
+
+	MESSAGE_FAILED_OPEN_DRAWER_SESSION  = "failed to open drawer session"
+	MESSAGE_FAILED_CLOSE_DRAWER_SESSION = "failed to close drawer session"
+	MESSAGE_FAILED_GET_DRAWER_SESSION   = "failed to get drawer session"
 )
 
 // CASH_DRAWER Success Messages
@@ -16,6 +20,10 @@ const (
 	MESSAGE_SUCCESS_UPDATE_CASH_DRAWER = "success update cash drawer"
 	MESSAGE_SUCCESS_DELETE_CASH_DRAWER = "success delete cash drawer"
 	MESSAGE_SUCCESS_GET_CASH_DRAWER    = "success get cash drawer"
+
+	MESSAGE_SUCCESS_OPEN_DRAWER_SESSION  = "success open drawer session"
+	MESSAGE_SUCCESS_CLOSE_DRAWER_SESSION = "success close drawer session"
+	MESSAGE_SUCCESS_GET_DRAWER_SESSION   = "success get drawer session"
 )
 
 // CASH_DRAWER Custom Errors
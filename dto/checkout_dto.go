@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+
+// PosCheckout groups every local row written by a single checkout so they can be stored in one transaction
+type PosCheckout struct {
+	Receipt        *entity.PosReceipt
+	Sales          []*entity.PosSale
+	CashDrawers    []*entity.PosCashDrawer
+	Invoices       []*entity.PosInvoice
+	OnlinePayments []*entity.PosOnlinePayment
+	OutboxMessages []*entity.PosOutboxMessage
+	IdempotencyKey *entity.PosIdempotencyKey // completed with the response when the checkout was sent with a key
+}
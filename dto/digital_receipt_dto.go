@@ -1,5 +1,7 @@
 package dto
 
+import "github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+
 type EmailReceiver struct {
 	EmailAddress string `json:"email_address"`
 }
@@ -13,23 +15,33 @@ type HeaderReceipt struct {
 }
 
 type Items struct {
-	ProductName string  `json:"product_name"`
-	Quantity    int     `json:"quantity"`
-	Price       float64 `json:"price"`
-	TotalPrice  float64 `json:"total_price"`
+	ProductName string       `json:"product_name"`
+	Quantity    int          `json:"quantity"`
+	Price       money.Amount `json:"price"`
+	TotalPrice  money.Amount `json:"total_price"`
 }
 
 type BodyReceipt struct {
 	Items []Items `json:"items"`
 }
 
+type TaxBreakdown struct {
+	TaxName       string       `json:"tax_name"`
+	Rate          float64      `json:"rate"`
+	TaxInclusive  bool         `json:"tax_inclusive"`
+	TaxableAmount money.Amount `json:"taxable_amount"`
+	TaxAmount     money.Amount `json:"tax_amount"`
+}
+
 type SummaryReceipt struct {
-	SubTotalAmount float64 `json:"sub_total_amount"`
-	DiscountAmoutn float64 `json:"discount_amount"`
-	TaxAmount      float64 `json:"tax_amount"`
-	TotalAmount    float64 `json:"total_amount"`
-	CashAmount     float64 `json:"cash_amount"`
-	ChangeAmount   float64 `json:"change_amount"`
+	SubTotalAmount money.Amount   `json:"sub_total_amount"`
+	DiscountAmoutn money.Amount   `json:"discount_amount"`
+	TaxAmount      money.Amount   `json:"tax_amount"`
+	TaxBreakdown   []TaxBreakdown `json:"tax_breakdown"`
+	TotalAmount    money.Amount   `json:"total_amount"`
+	CashAmount     money.Amount   `json:"cash_amount"`
+	ChangeAmount   money.Amount   `json:"change_amount"`
+	CurrencyCode   string         `json:"currency_code"`
 }
 
 type DigitalReceipt struct {
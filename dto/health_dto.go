@@ -0,0 +1,19 @@
+package dto
+
+const (
+	MESSAGE_SERVICE_ALIVE     = "service is alive"
+	MESSAGE_SERVICE_READY     = "service is ready"
+	MESSAGE_SERVICE_NOT_READY = "service is not ready"
+)
+
+// DependencyStatus is the health status the backend reports for one dependency
+type DependencyStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type ReadinessResponse struct {
+	Status       string             `json:"status"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
@@ -1,13 +1,18 @@
 package dto
 
+// Pagination selects a page by number, or by the token of the previous page when only Limit is set
 type Pagination struct {
-	Limit int `json:"limit"`
-	Page  int `json:"page"`
+	Limit         int    `json:"limit"`
+	Page          int    `json:"page"`
+	PageToken     string `json:"pageToken"`
+	EstimateCount bool   `json:"estimateCount"` // the planner estimate instead of counting every record
 }
 
 type PaginationResult struct {
-	TotalRecords int64       `json:"totalRecords"`
-	Records      interface{} `json:"records"`
-	CurrentPage  int         `json:"currentPage"`
-	TotalPages   int         `json:"totalPages"`
+	TotalRecords   int64       `json:"totalRecords"` // zero on the pages read with a page token, unless estimated
+	Records        interface{} `json:"records"`
+	CurrentPage    int         `json:"currentPage"`
+	TotalPages     int         `json:"totalPages"`
+	NextPageToken  string      `json:"nextPageToken"` // empty on the last page
+	CountEstimated bool        `json:"countEstimated"`
 }
@@ -0,0 +1,27 @@
+package dto
+
+import "errors"
+
+// PROMOTION_RULE Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_PROMOTION_RULE = "failed to create promotion rule"
+	MESSAGE_FAILED_UPDATE_PROMOTION_RULE = "failed to update promotion rule"
+	MESSAGE_FAILED_DELETE_PROMOTION_RULE = "failed to delete promotion rule"
+	MESSAGE_FAILED_GET_PROMOTION_RULE    = "failed to get promotion rule"
+)
+
+// PROMOTION_RULE Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_PROMOTION_RULE = "success create promotion rule"
+	MESSAGE_SUCCESS_UPDATE_PROMOTION_RULE = "success update promotion rule"
+	MESSAGE_SUCCESS_DELETE_PROMOTION_RULE = "success delete promotion rule"
+	MESSAGE_SUCCESS_GET_PROMOTION_RULE    = "success get promotion rule"
+)
+
+// PROMOTION_RULE Custom Errors
+var (
+	ErrCreatePromotionRule = errors.New(MESSAGE_FAILED_CREATE_PROMOTION_RULE)
+	ErrUpdatePromotionRule = errors.New(MESSAGE_FAILED_UPDATE_PROMOTION_RULE)
+	ErrDeletePromotionRule = errors.New(MESSAGE_FAILED_DELETE_PROMOTION_RULE)
+	ErrGetPromotionRule    = errors.New(MESSAGE_FAILED_GET_PROMOTION_RULE)
+)
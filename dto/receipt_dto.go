@@ -0,0 +1,24 @@
+package dto
+
+import "errors"
+
+// RECEIPT Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_RECEIPT = "failed to create receipt"
+	MESSAGE_FAILED_VOID_RECEIPT   = "failed to void receipt"
+	MESSAGE_FAILED_GET_RECEIPT    = "failed to get receipt"
+)
+
+// RECEIPT Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_RECEIPT = "success create receipt"
+	MESSAGE_SUCCESS_VOID_RECEIPT   = "success void receipt"
+	MESSAGE_SUCCESS_GET_RECEIPT    = "success get receipt"
+)
+
+// RECEIPT Custom Errors
+var (
+	ErrCreateReceipt = errors.New(MESSAGE_FAILED_CREATE_RECEIPT)
+	ErrVoidReceipt   = errors.New(MESSAGE_FAILED_VOID_RECEIPT)
+	ErrGetReceipt    = errors.New(MESSAGE_FAILED_GET_RECEIPT)
+)
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/google/uuid"
+)
+
+// PosReceiptVoid groups the void of a receipt and the records reversing its tenders
+// so they are stored in one transaction with the status change
+type PosReceiptVoid struct {
+	PosReceiptID   string
+	Reason         string
+	VoidedBy       uuid.UUID
+	VoidedAt       time.Time
+	CashDrawers    []*entity.PosCashDrawer
+	Invoices       []*entity.PosInvoice
+	OnlinePayments []*entity.PosOnlinePayment
+}
@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+)
+
+// REPORT Failed Messages
+const (
+	MESSAGE_FAILED_GET_DAILY_STORE_SUMMARY = "failed to get daily store summary"
+	MESSAGE_FAILED_GET_SALES_TIME_SERIES   = "failed to get sales time series"
+)
+
+// REPORT Success Messages
+const (
+	MESSAGE_SUCCESS_GET_DAILY_STORE_SUMMARY = "success get daily store summary"
+	MESSAGE_SUCCESS_GET_SALES_TIME_SERIES   = "success get sales time series"
+)
+
+// REPORT Custom Errors
+var (
+	ErrGetDailyStoreSummary = errors.New(MESSAGE_FAILED_GET_DAILY_STORE_SUMMARY)
+	ErrGetSalesTimeSeries   = errors.New(MESSAGE_FAILED_GET_SALES_TIME_SERIES)
+)
+
+// PosCashierSalesTotals sums the sale lines one cashier rang up
+type PosCashierSalesTotals struct {
+	CashierID    string
+	GrossSales   money.Amount
+	Discounts    money.Amount
+	Taxes        money.Amount
+	Transactions int
+}
+
+// PosCashierReturnTotals sums the refunds one cashier paid out, ReturnTaxes is the exclusive tax refunded with Returns
+type PosCashierReturnTotals struct {
+	CashierID   string
+	Returns     money.Amount
+	ReturnTaxes money.Amount
+	Count       int
+}
+
+// PosTenderTotals sums what one payment method took and refunded
+type PosTenderTotals struct {
+	PaymentMethodID string
+	MethodName      string
+	Sales           money.Amount
+	Refunds         money.Amount
+	Transactions    int
+}
+
+// Time series intervals, they are the units of date_trunc
+const (
+	SERIES_INTERVAL_HOUR  = "hour"
+	SERIES_INTERVAL_DAY   = "day"
+	SERIES_INTERVAL_WEEK  = "week"
+	SERIES_INTERVAL_MONTH = "month"
+)
+
+// Time series breakdowns
+const (
+	SERIES_GROUP_BY_STORE          = "store"
+	SERIES_GROUP_BY_BRANCH         = "branch"
+	SERIES_GROUP_BY_PAYMENT_METHOD = "payment_method"
+)
+
+// PosSalesSeriesQuery selects the rollups of a time series, from inclusive and to exclusive
+type PosSalesSeriesQuery struct {
+	Interval        string
+	Location        *time.Location
+	GroupBy         string
+	From            time.Time
+	To              time.Time
+	BranchID        string
+	StoreID         string
+	PaymentMethodID string
+}
+
+// PosSalesSeriesRow is one bucket of one series, Bucket is its start in the location of the query
+type PosSalesSeriesRow struct {
+	Bucket       time.Time
+	SeriesKey    string
+	Label        string
+	GrossSales   money.Amount
+	Discounts    money.Amount
+	Taxes        money.Amount
+	Returns      money.Amount
+	ReturnTaxes  money.Amount
+	Transactions int
+}
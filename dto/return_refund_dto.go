@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+
+// PosReturnRefund groups the return and the refund booked on the original tender
+// so they are stored in one transaction. Only one of CashDrawer, Invoice and OnlinePayment is set.
+type PosReturnRefund struct {
+	Return        *entity.PosReturn
+	CashDrawer    *entity.PosCashDrawer
+	Invoice       *entity.PosInvoice
+	OnlinePayment *entity.PosOnlinePayment
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "errors"
+
+// TAX_RATE Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_TAX_RATE = "failed to create tax rate"
+	MESSAGE_FAILED_UPDATE_TAX_RATE = "failed to update tax rate"
+	MESSAGE_FAILED_DELETE_TAX_RATE = "failed to delete tax rate"
+	MESSAGE_FAILED_GET_TAX_RATE    = "failed to get tax rate"
+)
+
+// TAX_RATE Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_TAX_RATE = "success create tax rate"
+	MESSAGE_SUCCESS_UPDATE_TAX_RATE = "success update tax rate"
+	MESSAGE_SUCCESS_DELETE_TAX_RATE = "success delete tax rate"
+	MESSAGE_SUCCESS_GET_TAX_RATE    = "success get tax rate"
+)
+
+// TAX_RATE Custom Errors
+var (
+	ErrCreateTaxRate = errors.New(MESSAGE_FAILED_CREATE_TAX_RATE)
+	ErrUpdateTaxRate = errors.New(MESSAGE_FAILED_UPDATE_TAX_RATE)
+	ErrDeleteTaxRate = errors.New(MESSAGE_FAILED_DELETE_TAX_RATE)
+	ErrGetTaxRate    = errors.New(MESSAGE_FAILED_GET_TAX_RATE)
+)
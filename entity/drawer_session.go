@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Drawer session statuses
+const (
+	DRAWER_SESSION_OPEN   = "OPEN"
+	DRAWER_SESSION_CLOSED = "CLOSED"
+)
+
+// PosDrawerSession is one cashier shift on a cash drawer. The cash drawer ledger rows of the shift
+// point back to it, so the expected cash at close is the opening float plus their cash in minus cash out.
+type PosDrawerSession struct {
+	SessionID     uuid.UUID                      `gorm:"type:uuid;primary_key" json:"session_id"`
+	StoreID       uuid.UUID                      `gorm:"type:uuid;not null;index" json:"store_id"`
+	BranchID      uuid.UUID                      `gorm:"type:uuid;not null;index" json:"branch_id"`
+	CompanyID     uuid.UUID                      `gorm:"type:uuid;not null" json:"company_id"`
+	CashierID     uuid.UUID                      `gorm:"type:uuid;not null" json:"cashier_id"`
+	Status        string                         `gorm:"type:varchar(20);not null" json:"status"`
+	OpeningFloat  money.Amount                   `gorm:"type:decimal(10,2);not null" json:"opening_float"`
+	ExpectedCash  money.Amount                   `gorm:"type:decimal(10,2);not null" json:"expected_cash"`
+	CountedCash   money.Amount                   `gorm:"type:decimal(10,2);not null" json:"counted_cash"`
+	Variance      money.Amount                   `gorm:"type:decimal(10,2);not null" json:"variance"`
+	Denominations []PosDrawerSessionDenomination `gorm:"foreignkey:SessionID;association_foreignkey:SessionID" json:"denominations"`
+	OpenedAt      time.Time                      `gorm:"type:timestamp;not null" json:"opened_at"`
+	ClosedAt      *time.Time                     `gorm:"type:timestamp" json:"closed_at"`
+	ClosedBy      *uuid.UUID                     `gorm:"type:uuid" json:"closed_by"`
+	CreatedAt     time.Time                      `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy     uuid.UUID                      `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt     time.Time                      `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy     uuid.UUID                      `gorm:"type:uuid" json:"updated_by"`
+}
+
+// PosDrawerSessionDenomination is the blind count of one denomination when a session is closed
+type PosDrawerSessionDenomination struct {
+	DenominationID uuid.UUID    `gorm:"type:uuid;primary_key" json:"denomination_id"`
+	SessionID      uuid.UUID    `gorm:"type:uuid;not null;index" json:"session_id"`
+	Denomination   money.Amount `gorm:"type:decimal(10,2);not null" json:"denomination"`
+	Count          int          `gorm:"type:int;not null" json:"count"`
+	Amount         money.Amount `gorm:"type:decimal(10,2);not null" json:"amount"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Idempotency key status
+const (
+	IDEMPOTENCY_STATUS_IN_PROGRESS = "IN_PROGRESS"
+	IDEMPOTENCY_STATUS_COMPLETED   = "COMPLETED"
+)
+
+// PosIdempotencyKey remembers a checkout sent with an Idempotency-Key so a retry is answered with its first response.
+// Keys are scoped to the user who sent them.
+type PosIdempotencyKey struct {
+	UserID         uuid.UUID `gorm:"type:uuid;primary_key" json:"user_id"`
+	IdempotencyKey string    `gorm:"type:varchar(255);primary_key" json:"idempotency_key"`
+	Method         string    `gorm:"type:varchar(255);not null" json:"method"`
+	RequestHash    string    `gorm:"type:varchar(64);not null" json:"request_hash"` // SHA-256 of the method and the request
+	Status         string    `gorm:"type:varchar(20);not null" json:"status"`
+	Response       []byte    `gorm:"type:bytea" json:"response"` // protobuf encoded response of the completed checkout
+	ExpiresAt      time.Time `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	CreatedAt      time.Time `gorm:"type:timestamp" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"type:timestamp" json:"updated_at"`
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Outbox message status
+const (
+	OUTBOX_STATUS_PENDING   = "PENDING"
+	OUTBOX_STATUS_PUBLISHED = "PUBLISHED"
+	OUTBOX_STATUS_DEAD      = "DEAD"
+)
+
+type PosOutboxMessage struct {
+	MessageID     uuid.UUID  `gorm:"type:uuid;primary_key" json:"message_id"`
+	AggregateID   string     `gorm:"type:varchar(255);not null" json:"aggregate_id"`
+	EventType     string     `gorm:"type:varchar(100);not null" json:"event_type"`
+	RoutingKey    string     `gorm:"type:varchar(255);not null" json:"routing_key"`
+	Payload       string     `gorm:"type:text;not null" json:"payload"`
+	TraceContext  string     `gorm:"type:text" json:"trace_context"` // W3C trace context of the call that queued the message
+	Status        string     `gorm:"type:varchar(20);not null;index" json:"status"`
+	Attempts      int        `gorm:"type:int;not null" json:"attempts"`
+	LastError     string     `gorm:"type:text" json:"last_error"`
+	NextAttemptAt time.Time  `gorm:"type:timestamp;not null;index" json:"next_attempt_at"`
+	PublishedAt   *time.Time `gorm:"type:timestamp" json:"published_at"`
+	CreatedAt     time.Time  `gorm:"type:timestamp" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"type:timestamp" json:"updated_at"`
+}
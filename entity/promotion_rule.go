@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Promotion rule types
+const (
+	PROMOTION_RULE_PERCENTAGE        = "PERCENTAGE"
+	PROMOTION_RULE_FIXED_AMOUNT      = "FIXED_AMOUNT"
+	PROMOTION_RULE_BUY_X_GET_Y       = "BUY_X_GET_Y"
+	PROMOTION_RULE_BUNDLE            = "BUNDLE"
+	PROMOTION_RULE_RECEIPT_THRESHOLD = "RECEIPT_THRESHOLD"
+)
+
+// PosPromotionRule is a basket pricing rule. ProductIDs is a comma separated list of product ids,
+// StoreID and BranchID narrow the rule down to one store or branch of the company.
+type PosPromotionRule struct {
+	PromotionRuleID uuid.UUID    `gorm:"type:uuid;primary_key" json:"promotion_rule_id"`
+	Name            string       `gorm:"type:varchar(255);not null" json:"name"`
+	RuleType        string       `gorm:"type:varchar(30);not null" json:"rule_type"`
+	ProductIDs      string       `gorm:"type:text" json:"product_ids"`
+	DiscountRate    float64      `gorm:"type:decimal(6,4);not null" json:"discount_rate"`
+	DiscountAmount  money.Amount `gorm:"type:decimal(10,2);not null" json:"discount_amount"`
+	BuyQuantity     int          `gorm:"type:int;not null" json:"buy_quantity"`
+	GetQuantity     int          `gorm:"type:int;not null" json:"get_quantity"`
+	BundlePrice     money.Amount `gorm:"type:decimal(10,2);not null" json:"bundle_price"`
+	MinSubtotal     money.Amount `gorm:"type:decimal(10,2);not null" json:"min_subtotal"`
+	StartDate       time.Time    `gorm:"type:timestamp;not null" json:"start_date"`
+	EndDate         time.Time    `gorm:"type:timestamp;not null" json:"end_date"`
+	Active          bool         `gorm:"not null" json:"active"`
+	StoreID         *uuid.UUID   `gorm:"type:uuid" json:"store_id"`
+	BranchID        *uuid.UUID   `gorm:"type:uuid" json:"branch_id"`
+	CompanyID       uuid.UUID    `gorm:"type:uuid;not null;index" json:"company_id"`
+	CreatedAt       time.Time    `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy       uuid.UUID    `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt       time.Time    `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy       uuid.UUID    `gorm:"type:uuid" json:"updated_by"`
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Receipt status
+const (
+	RECEIPT_STATUS_COMPLETED = "COMPLETED"
+	RECEIPT_STATUS_VOIDED    = "VOIDED"
+)
+
+type PosReceipt struct {
+	PosReceiptID  uuid.UUID    `gorm:"type:uuid;primary_key" json:"pos_receipt_id"`
+	ReceiptID     string       `gorm:"type:varchar(255);not null;index" json:"receipt_id"`
+	StoreID       uuid.UUID    `gorm:"type:uuid;not null" json:"store_id"`
+	BranchID      uuid.UUID    `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID     uuid.UUID    `gorm:"type:uuid;not null" json:"company_id"`
+	CustomerID    uuid.UUID    `gorm:"type:uuid;not null" json:"customer_id"`
+	CashierID     uuid.UUID    `gorm:"type:uuid;not null" json:"cashier_id"`
+	Status        string       `gorm:"type:varchar(20);not null" json:"status"`
+	SubTotal      money.Amount `gorm:"type:decimal(10,2);not null" json:"sub_total"`
+	DiscountTotal money.Amount `gorm:"type:decimal(10,2);not null" json:"discount_total"`
+	TaxTotal      money.Amount `gorm:"type:decimal(10,2);not null" json:"tax_total"`
+	Total         money.Amount `gorm:"type:decimal(10,2);not null" json:"total"`
+	CashTendered  money.Amount `gorm:"type:decimal(10,2);not null" json:"cash_tendered"`
+	ChangeAmount  money.Amount `gorm:"type:decimal(10,2);not null" json:"change_amount"`
+	// CurrencyCode is the currency the receipt was taken in, receipts from before it was stored have none
+	CurrencyCode string `gorm:"type:varchar(3)" json:"currency_code"`
+	// ReceiptPromotionID is the receipt threshold promotion spread over the lines, if any
+	ReceiptPromotionID string             `gorm:"type:varchar(255)" json:"receipt_promotion_id"`
+	ReceiptDate        time.Time          `gorm:"type:timestamp;not null" json:"receipt_date"`
+	VoidReason         string             `gorm:"type:text" json:"void_reason"`
+	VoidedAt           *time.Time         `gorm:"type:timestamp" json:"voided_at"`
+	VoidedBy           *uuid.UUID         `gorm:"type:uuid" json:"voided_by"`
+	Lines              []PosReceiptLine   `gorm:"foreignkey:PosReceiptID;association_foreignkey:PosReceiptID" json:"lines"`
+	Tenders            []PosReceiptTender `gorm:"foreignkey:PosReceiptID;association_foreignkey:PosReceiptID" json:"tenders"`
+	CreatedAt          time.Time          `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy          uuid.UUID          `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt          time.Time          `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy          uuid.UUID          `gorm:"type:uuid" json:"updated_by"`
+}
+
+type PosReceiptLine struct {
+	LineID         uuid.UUID    `gorm:"type:uuid;primary_key" json:"line_id"`
+	PosReceiptID   uuid.UUID    `gorm:"type:uuid;not null;index" json:"pos_receipt_id"`
+	SaleID         uuid.UUID    `gorm:"type:uuid;not null" json:"sale_id"`
+	ProductID      uuid.UUID    `gorm:"type:uuid;not null" json:"product_id"`
+	ProductName    string       `gorm:"type:varchar(255)" json:"product_name"`
+	Quantity       int          `gorm:"type:int;not null" json:"quantity"`
+	UnitPrice      money.Amount `gorm:"type:decimal(10,2);not null" json:"unit_price"`
+	DiscountAmount money.Amount `gorm:"type:decimal(10,2);not null" json:"discount_amount"`
+	TotalPrice     money.Amount `gorm:"type:decimal(10,2);not null" json:"total_price"`
+	TaxRateID      *uuid.UUID   `gorm:"type:uuid" json:"tax_rate_id"`
+	TaxRate        float64      `gorm:"type:decimal(6,4);not null" json:"tax_rate"`
+	TaxAmount      money.Amount `gorm:"type:decimal(10,2);not null" json:"tax_amount"`
+	PromotionID    string       `gorm:"type:varchar(255)" json:"promotion_id"`
+	TaxInclusive   bool         `gorm:"not null;default:false" json:"tax_inclusive"`
+}
+
+type PosReceiptTender struct {
+	TenderID          uuid.UUID    `gorm:"type:uuid;primary_key" json:"tender_id"`
+	PosReceiptID      uuid.UUID    `gorm:"type:uuid;not null;index" json:"pos_receipt_id"`
+	PaymentMethodID   uuid.UUID    `gorm:"type:uuid;not null" json:"payment_method_id"`
+	PaymentMethodName string       `gorm:"type:varchar(255)" json:"payment_method_name"`
+	Amount            money.Amount `gorm:"type:decimal(10,2);not null" json:"amount"`
+	CashTendered      money.Amount `gorm:"type:decimal(10,2);not null" json:"cash_tendered"`
+	ChangeAmount      money.Amount `gorm:"type:decimal(10,2);not null" json:"change_amount"`
+}
@@ -3,23 +3,31 @@ package entity
 import (
 	"time"
 
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
 	"github.com/google/uuid"
 )
 
+// PosReturn is a returned quantity of one sale line, refunded on the receipt tender TenderID paid with PaymentMethodID.
+// Returns booked before tenders were recorded have no TenderID.
 type PosReturn struct {
-	ReturnID   uuid.UUID `gorm:"type:uuid;primary_key" json:"return_id"`
-	ReceiptID  string    `gorm:"not null" json:"receipt_id"`
-	ProductID  uuid.UUID `gorm:"type:uuid;not null" json:"product_id"`
-	Quantity   int       `gorm:"type:int;not null" json:"quantity"`
-	Price      float64   `gorm:"type:decimal(10,2);not null" json:"price"`
-	Amount     float64   `gorm:"type:decimal(10,2);not null" json:"amount"`
-	ReturnDate time.Time `gorm:"type:timestamp;not null" json:"return_date"`
-	Reason     string    `gorm:"type:text" json:"reason"`
-	StoreID    uuid.UUID `gorm:"type:uuid" json:"store_id"`
-	BranchID   uuid.UUID `gorm:"type:uuid;not null" json:"branch_id"`
-	CompanyID  uuid.UUID `gorm:"type:uuid;not null" json:"company_id"`
-	CreatedAt  time.Time `gorm:"type:timestamp" json:"created_at"`
-	CreatedBy  uuid.UUID `gorm:"type:uuid" json:"created_by"`
-	UpdatedAt  time.Time `gorm:"type:timestamp" json:"updated_at"`
-	UpdatedBy  uuid.UUID `gorm:"type:uuid" json:"updated_by"`
+	ReturnID        uuid.UUID    `gorm:"type:uuid;primary_key" json:"return_id"`
+	ReceiptID       string       `gorm:"not null" json:"receipt_id"`
+	PosReceiptID    uuid.UUID    `gorm:"type:uuid;index" json:"pos_receipt_id"`
+	SaleID          uuid.UUID    `gorm:"type:uuid;index" json:"sale_id"`
+	PaymentMethodID uuid.UUID    `gorm:"type:uuid" json:"payment_method_id"`
+	TenderID        *uuid.UUID   `gorm:"type:uuid;index" json:"tender_id"`
+	ProductID       uuid.UUID    `gorm:"type:uuid;not null" json:"product_id"`
+	Quantity        int          `gorm:"type:int;not null" json:"quantity"`
+	Price           money.Amount `gorm:"type:decimal(10,2);not null" json:"price"`
+	Amount          money.Amount `gorm:"type:decimal(10,2);not null" json:"amount"`
+	TaxAmount       money.Amount `gorm:"type:decimal(10,2);not null;default:0" json:"tax_amount"` // exclusive tax refunded with Amount
+	ReturnDate      time.Time    `gorm:"type:timestamp;not null" json:"return_date"`
+	Reason          string       `gorm:"type:text" json:"reason"`
+	StoreID         uuid.UUID    `gorm:"type:uuid" json:"store_id"`
+	BranchID        uuid.UUID    `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID       uuid.UUID    `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt       time.Time    `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy       uuid.UUID    `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt       time.Time    `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy       uuid.UUID    `gorm:"type:uuid" json:"updated_by"`
 }
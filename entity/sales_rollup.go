@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/google/uuid"
+)
+
+// PosSalesRollup sums the receipts and returns of a store for one UTC hour and one payment method.
+// The rows are added to in the transactions that write the sales, returns and voids, the dashboards read only these.
+// A receipt paid with several tenders is split over their methods in proportion to the tendered amounts,
+// the transaction is counted on the method of its first tender.
+type PosSalesRollup struct {
+	// The dashboards read a company or a branch over a period, the indexes lead with them
+	CompanyID       uuid.UUID    `gorm:"type:uuid;not null;index:idx_pos_sales_rollups_company_bucket" json:"company_id"`
+	BranchID        uuid.UUID    `gorm:"type:uuid;not null;index:idx_pos_sales_rollups_branch_bucket" json:"branch_id"`
+	BucketStart     time.Time    `gorm:"type:timestamp;primary_key;index:idx_pos_sales_rollups_company_bucket,idx_pos_sales_rollups_branch_bucket" json:"bucket_start"`
+	StoreID         uuid.UUID    `gorm:"type:uuid;primary_key" json:"store_id"`
+	PaymentMethodID uuid.UUID    `gorm:"type:uuid;primary_key" json:"payment_method_id"`
+	GrossSales      money.Amount `gorm:"type:decimal(18,2);not null;default:0" json:"gross_sales"`
+	Discounts       money.Amount `gorm:"type:decimal(18,2);not null;default:0" json:"discounts"`
+	Taxes           money.Amount `gorm:"type:decimal(18,2);not null;default:0" json:"taxes"`
+	Returns         money.Amount `gorm:"type:decimal(18,2);not null;default:0" json:"returns"`
+	ReturnTaxes     money.Amount `gorm:"type:decimal(18,2);not null;default:0" json:"return_taxes"` // exclusive tax refunded with Returns
+	Transactions    int          `gorm:"type:int;not null;default:0" json:"transactions"`
+	UpdatedAt       time.Time    `gorm:"type:timestamp" json:"updated_at"`
+}
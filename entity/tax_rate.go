@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PosTaxRate applies to the whole company unless StoreID and/or CategoryID narrow it down
+type PosTaxRate struct {
+	TaxRateID    uuid.UUID  `gorm:"type:uuid;primary_key" json:"tax_rate_id"`
+	TaxName      string     `gorm:"type:varchar(255);not null" json:"tax_name"`
+	Rate         float64    `gorm:"type:decimal(6,4);not null" json:"rate"`
+	TaxInclusive bool       `gorm:"not null" json:"tax_inclusive"`
+	StoreID      *uuid.UUID `gorm:"type:uuid" json:"store_id"`
+	CategoryID   *uuid.UUID `gorm:"type:uuid" json:"category_id"`
+	CompanyID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"company_id"`
+	CreatedAt    time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy    uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt    time.Time  `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy    uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Identity is the verified caller of a request, Token is forwarded to the upstream services
+type Identity struct {
+	Payload *pb.JWTPayload
+	Token   string
+}
+
+type identityContextKey struct{}
+
+// WithIdentity returns a copy of ctx that carries the verified identity
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// FromContext returns the identity verified for the call, services read the caller from here and not from the request
+func FromContext(ctx context.Context) (*Identity, error) {
+	identity, ok := ctx.Value(identityContextKey{}).(*Identity)
+	if !ok || identity == nil || identity.Payload == nil {
+		return nil, status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+	return identity, nil
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const authorizationMetadata = "authorization"
+
+// publicMethodPrefixes are served without a token
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+}
+
+// UnaryServerInterceptor verifies the bearer token of the authorization metadata and puts the identity in the context.
+// Calls without a valid token are rejected with Unauthenticated before they reach a service.
+func UnaryServerInterceptor(verifier *Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for _, prefix := range publicMethodPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(ctx, req)
+			}
+		}
+
+		var authorization string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(authorizationMetadata); len(values) > 0 {
+				authorization = values[0]
+			}
+		}
+
+		token, err := BearerToken(authorization)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		payload, err := verifier.Verify(token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(WithIdentity(ctx, &Identity{Payload: payload, Token: token}), req)
+	}
+}
+
+// UnaryClientInterceptor forwards the bearer token of the identity in the context, when there is one
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if identity, ok := ctx.Value(identityContextKey{}).(*Identity); ok && identity != nil && identity.Token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, authorizationMetadata, "Bearer "+identity.Token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
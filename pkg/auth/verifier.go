@@ -0,0 +1,83 @@
+// Package auth verifies the bearer tokens of the callers and carries the verified identity in the context.
+// The gateway and the gRPC server both verify the token, the gRPC server never trusts the payload of a request.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/dgrijalva/jwt-go"
+)
+
+var (
+	ErrMissingToken = errors.New("authorization token not provided")
+	ErrInvalidToken = errors.New("invalid authorization token")
+)
+
+// claims checks the expiry and issuer of a parsed token
+type claims struct {
+	*pb.JWTPayload
+	expectedIssuer string
+}
+
+func (c *claims) Valid() error {
+	if c.StandardClaims == nil {
+		return errors.New("token has no standard claims")
+	}
+	if c.StandardClaims.ExpiresAt < time.Now().Unix() {
+		return errors.New("token is expired")
+	}
+	if c.StandardClaims.Issuer != c.expectedIssuer {
+		return errors.New("invalid issuer")
+	}
+	return nil
+}
+
+// Verifier checks the signature, issuer and expiry of a token with the auth settings
+type Verifier struct {
+	secretKey []byte
+	issuer    string
+}
+
+func NewVerifier(settings config.AuthSettings) *Verifier {
+	return &Verifier{
+		secretKey: []byte(settings.SecretKey),
+		issuer:    settings.Issuer,
+	}
+}
+
+// Verify returns the payload of a valid HMAC signed token
+func (v *Verifier) Verify(token string) (*pb.JWTPayload, error) {
+	parsed, err := jwt.ParseWithClaims(token, &claims{JWTPayload: &pb.JWTPayload{}, expectedIssuer: v.issuer}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return v.secretKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	verified, ok := parsed.Claims.(*claims)
+	if !ok || !parsed.Valid {
+		return nil, ErrInvalidToken
+	}
+	return verified.JWTPayload, nil
+}
+
+// BearerToken returns the token of an "Authorization: Bearer <token>" value
+func BearerToken(authorization string) (string, error) {
+	if authorization == "" {
+		return "", ErrMissingToken
+	}
+
+	parts := strings.Split(authorization, " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || parts[1] == "" {
+		return "", errors.New("invalid authorization token format")
+	}
+	return parts[1], nil
+}
@@ -0,0 +1,74 @@
+// Package health keeps the gRPC health service up to date with the state of every dependency.
+package health
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Probe checks one dependency, its name is the service name reported by the gRPC health service
+type Probe struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Checker runs the probes on an interval and reports every dependency under its own name.
+// The overall status, the empty service name, is SERVING only while every probe passes.
+type Checker struct {
+	server   *health.Server
+	probes   []Probe
+	interval time.Duration
+	timeout  time.Duration
+	logger   *slog.Logger
+}
+
+func NewChecker(server *health.Server, interval time.Duration, timeout time.Duration, logger *slog.Logger, probes ...Probe) *Checker {
+	return &Checker{
+		server:   server,
+		probes:   probes,
+		interval: interval,
+		timeout:  timeout,
+		logger:   logger.With(slog.String("component", "health_checker")),
+	}
+}
+
+// Run probes right away and then on every interval until ctx is done
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckOnce runs every probe and updates the health service
+func (c *Checker) CheckOnce(ctx context.Context) {
+	overall := healthpb.HealthCheckResponse_SERVING
+
+	for _, probe := range c.probes {
+		probeCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		err := probe.Check(probeCtx)
+		cancel()
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			c.logger.WarnContext(ctx, "health check failed", slog.String("dependency", probe.Name), slog.String("error", err.Error()))
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			overall = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		c.server.SetServingStatus(probe.Name, status)
+	}
+
+	c.server.SetServingStatus("", overall)
+}
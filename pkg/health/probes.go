@@ -0,0 +1,91 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Dependency names reported by the gRPC health service
+const (
+	POSTGRES        = "postgres"
+	REDIS           = "redis"
+	RABBITMQ        = "rabbitmq"
+	PRODUCT_SERVICE = "product-service"
+	COMPANY_SERVICE = "company-service"
+)
+
+// DEPENDENCIES lists the dependencies the server reports, in the order the readiness endpoint shows them
+var DEPENDENCIES = []string{POSTGRES, REDIS, RABBITMQ, PRODUCT_SERVICE, COMPANY_SERVICE}
+
+func PostgresProbe(db *gorm.DB) Probe {
+	return Probe{
+		Name: POSTGRES,
+		Check: func(ctx context.Context) error {
+			if db == nil {
+				return errors.New("postgres is not connected")
+			}
+			return db.DB().PingContext(ctx)
+		},
+	}
+}
+
+func RedisProbe(client *redis.Client) Probe {
+	return Probe{
+		Name: REDIS,
+		Check: func(ctx context.Context) error {
+			if client == nil {
+				return errors.New("redis is not connected")
+			}
+			return client.Ping(ctx).Err()
+		},
+	}
+}
+
+// RabbitMQProbe redials a closed connection, the same way the outbox dispatcher does
+func RabbitMQProbe(rabbitMQ *config.RabbitMqConfig) Probe {
+	return Probe{
+		Name: RABBITMQ,
+		Check: func(ctx context.Context) error {
+			if rabbitMQ == nil {
+				return errors.New("rabbitmq is not connected")
+			}
+			_, err := rabbitMQ.Connection()
+			return err
+		},
+	}
+}
+
+// GrpcConnProbe asks an idle connection to connect and fails while it cannot reach any address
+func GrpcConnProbe(name string, conn *grpc.ClientConn) Probe {
+	return Probe{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			if conn == nil {
+				return fmt.Errorf("%s is not connected", name)
+			}
+
+			conn.Connect()
+			for {
+				state := conn.GetState()
+				switch state {
+				case connectivity.Ready:
+					return nil
+				case connectivity.TransientFailure, connectivity.Shutdown:
+					return fmt.Errorf("%s connection is %s", name, state)
+				}
+
+				if !conn.WaitForStateChange(ctx, state) {
+					return fmt.Errorf("%s connection is still %s: %w", name, state, ctx.Err())
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"unicode"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// IDEMPOTENCY_KEY_HEADER is sent by the tills with every checkout they may retry
+	IDEMPOTENCY_KEY_HEADER = "Idempotency-Key"
+	// IDEMPOTENCY_KEY_METADATA carries the key from the gateway to the gRPC server
+	IDEMPOTENCY_KEY_METADATA = "idempotency-key"
+	// MAX_KEY_LENGTH bounds the key, a UUID is the expected value
+	MAX_KEY_LENGTH = 255
+)
+
+// ErrInvalidKey is returned for a key that is too long or not printable ASCII
+var ErrInvalidKey = errors.New("idempotency key must be 1 to 255 printable ASCII characters")
+
+type keyContextKey struct{}
+
+// WithKey returns a copy of ctx that carries the idempotency key, the gRPC calls made with it forward the key
+func WithKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, keyContextKey{}, key)
+}
+
+// Key returns the idempotency key carried by ctx, or an empty string
+func Key(ctx context.Context) string {
+	key, _ := ctx.Value(keyContextKey{}).(string)
+	return key
+}
+
+// FromIncomingContext returns the idempotency key of the gRPC metadata, or an empty string when the call has none
+func FromIncomingContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	values := md.Get(IDEMPOTENCY_KEY_METADATA)
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	if err := Validate(values[0]); err != nil {
+		return "", err
+	}
+	return values[0], nil
+}
+
+// Validate checks a key before it is stored
+func Validate(key string) error {
+	if key == "" || len(key) > MAX_KEY_LENGTH {
+		return ErrInvalidKey
+	}
+	for _, r := range key {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// UnaryClientInterceptor forwards the idempotency key of the context, when there is one
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if key := Key(ctx); key != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, IDEMPOTENCY_KEY_METADATA, key)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// UnaryServerInterceptor takes the request id from the incoming metadata, or generates one,
+// puts it in the context of the handler and logs the outcome of every call
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(REQUEST_ID_METADATA); len(values) > 0 {
+				requestID = values[0]
+			}
+		}
+		if requestID == "" {
+			requestID = NewRequestID()
+		}
+		ctx = WithRequestID(ctx, requestID)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		attrs := []any{
+			slog.String("method", info.FullMethod),
+			slog.String("code", status.Code(err).String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		switch {
+		case err != nil:
+			logger.WarnContext(ctx, "grpc call failed", append(attrs, slog.String("error", err.Error()))...)
+		case strings.HasPrefix(info.FullMethod, healthMethodPrefix):
+			// Health checks run every few seconds, they would drown the other calls
+			logger.DebugContext(ctx, "grpc call", attrs...)
+		default:
+			logger.InfoContext(ctx, "grpc call", attrs...)
+		}
+
+		return resp, err
+	}
+}
+
+// RecoveryUnaryServerInterceptor turns a panic of a handler into an Internal error and logs it with its stack,
+// one bad request does not take the server down
+func RecoveryUnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				logger.ErrorContext(ctx, "grpc call panicked",
+					slog.String("method", info.FullMethod),
+					slog.Any("panic", recovered),
+					slog.String("stack", string(debug.Stack())))
+				resp, err = nil, status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// UnaryClientInterceptor sends the request id of the context along with every outgoing call
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if requestID := RequestID(ctx); requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, REQUEST_ID_METADATA, requestID)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
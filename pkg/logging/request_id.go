@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// REQUEST_ID_HEADER carries the request id over HTTP, in requests to the upstream services and in every gateway response
+	REQUEST_ID_HEADER = "X-Request-ID"
+	// REQUEST_ID_METADATA carries the request id over gRPC
+	REQUEST_ID_METADATA = "x-request-id"
+	// REQUEST_ID_KEY is the attribute of the request id in log records and the field in error responses
+	REQUEST_ID_KEY = "request_id"
+)
+
+type requestIDContextKey struct{}
+
+// WithRequestID returns a copy of ctx that carries the request id
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestID returns the request id carried by ctx, or an empty string
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
+
+// NewRequestID generates the id of a request that does not bring one
+func NewRequestID() string {
+	return uuid.NewString()
+}
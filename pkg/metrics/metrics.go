@@ -0,0 +1,146 @@
+// Package metrics holds the Prometheus collectors of both binaries and the interceptors that feed them.
+// Everything is registered on the default registry and served by Handler.
+package metrics
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "pos_sales"
+
+var (
+	grpcServerHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handled_total",
+		Help:      "gRPC calls handled by the server, by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcServerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handling_seconds",
+		Help:      "Time the server took to handle a gRPC call.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests handled by the gateway, by route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Time the gateway took to answer an HTTP request.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	upstreamDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "upstream_call_duration_seconds",
+		Help:      "Time of the calls to the upstream services, by service, operation and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "operation", "outcome"})
+
+	upstreamFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upstream_call_failures_total",
+		Help:      "Failed calls to the upstream services.",
+	}, []string{"service", "operation"})
+
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_lookups_total",
+		Help:      "Redis cache lookups of the repositories, by cache and result (hit, miss, error).",
+	}, []string{"cache", "result"})
+
+	roleLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "role_lookups_total",
+		Help:      "Role lookups of the permission checks, by where the role came from (memory, redis, upstream, stale) or failed.",
+	}, []string{"result"})
+
+	salesCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sales_total",
+		Help:      "Completed checkouts, by store.",
+	}, []string{"store_id"})
+
+	salesAmount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sales_amount_total",
+		Help:      "Amount paid in completed checkouts in major currency units, by store, payment method and currency.",
+	}, []string{"store_id", "payment_method", "currency"})
+
+	returnsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "returns_total",
+		Help:      "Accepted returns, by store.",
+	}, []string{"store_id"})
+
+	returnsAmount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "returns_amount_total",
+		Help:      "Amount refunded for returns in major currency units, by store, payment method and currency.",
+	}, []string{"store_id", "payment_method", "currency"})
+
+	drawerEntries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cash_drawer_entries_total",
+		Help:      "Cash drawer entries recorded, by store and source (sale, return, manual).",
+	}, []string{"store_id", "source"})
+)
+
+// Handler serves the collected metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveCacheLookup counts the result of a Redis GET, redis.Nil is a miss
+func ObserveCacheLookup(cache string, err error) {
+	result := "hit"
+	switch {
+	case errors.Is(err, redis.Nil):
+		result = "miss"
+	case err != nil:
+		result = "error"
+	}
+	cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+// ObserveRoleLookup counts a role lookup by where the role came from, or "failed"
+func ObserveRoleLookup(result string) {
+	roleLookups.WithLabelValues(result).Inc()
+}
+
+// ObserveSale counts a completed checkout, the amount is added once per tender
+func ObserveSale(storeID string) {
+	salesCount.WithLabelValues(storeID).Inc()
+}
+
+// ObserveSaleTender adds the amount paid with one payment method in a completed checkout
+func ObserveSaleTender(storeID string, paymentMethod string, amount money.Amount) {
+	salesAmount.WithLabelValues(storeID, paymentMethod, money.Currency()).Add(amount.Float64())
+}
+
+// ObserveReturn counts an accepted return and the amount refunded for it
+func ObserveReturn(storeID string, paymentMethod string, amount money.Amount) {
+	returnsCount.WithLabelValues(storeID).Inc()
+	returnsAmount.WithLabelValues(storeID, paymentMethod, money.Currency()).Add(amount.Float64())
+}
+
+// ObserveDrawerEntries counts the cash drawer entries written for one source
+func ObserveDrawerEntries(storeID string, source string, count int) {
+	if count <= 0 {
+		return
+	}
+	drawerEntries.WithLabelValues(storeID, source).Add(float64(count))
+}
@@ -0,0 +1,286 @@
+// Package money holds amounts as integer minor units so totals reconcile to the cent.
+//
+// Rounding rules: converting from a float or applying a rate rounds half away from zero to the nearest
+// minor unit, and splitting an amount hands the rounding remainder out one minor unit at a time so
+// the parts always add up to the whole.
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+)
+
+// MinorUnits is the number of decimals of every amount, it matches the decimal(10,2) columns
+const MinorUnits = 2
+
+const scale = 100
+
+// DEFAULT_CURRENCY is used when CURRENCY_CODE is not set
+const DEFAULT_CURRENCY = "IDR"
+
+// Amount is a monetary amount in minor units, 1050 is 10.50
+type Amount int64
+
+// FromFloat converts a float amount, such as a price from the product service, rounding half away from zero
+func FromFloat(value float64) Amount {
+	return Amount(math.Round(value * scale))
+}
+
+// Parse reads a decimal amount such as "10.5" or "-3.25" without going through a float
+func Parse(value string) (Amount, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, errors.New("empty amount")
+	}
+
+	// At most one sign, the digits that follow are checked below
+	digits := value
+	negative := strings.HasPrefix(digits, "-")
+	if negative || strings.HasPrefix(digits, "+") {
+		digits = digits[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(digits, ".")
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	// Digits beyond the minor unit are rounded half away from zero
+	var roundUp bool
+	if len(fraction) > MinorUnits {
+		roundUp = fraction[MinorUnits] >= '5'
+		fraction = fraction[:MinorUnits]
+	}
+	fraction += strings.Repeat("0", MinorUnits-len(fraction))
+
+	cents, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	amount := units*scale + cents
+	if roundUp {
+		amount++
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return Amount(amount), nil
+}
+
+func isDigits(value string) bool {
+	for _, digit := range value {
+		if digit < '0' || digit > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64 returns the amount as a float, for display and for remote services that still use floats
+func (a Amount) Float64() float64 {
+	return float64(a) / scale
+}
+
+// String formats the amount with exactly two decimals
+func (a Amount) String() string {
+	sign := ""
+	value := int64(a)
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, value/scale, value%scale)
+}
+
+// Times multiplies the amount by a quantity
+func (a Amount) Times(quantity int) Amount {
+	return a * Amount(quantity)
+}
+
+// MulRate applies a rate such as a tax or discount rate, rounding half away from zero
+func (a Amount) MulRate(rate float64) Amount {
+	return Amount(math.Round(float64(a) * rate))
+}
+
+// DivRate divides the amount by a factor such as 1 + tax rate, rounding half away from zero
+func (a Amount) DivRate(factor float64) Amount {
+	if factor == 0 {
+		return 0
+	}
+	return Amount(math.Round(float64(a) / factor))
+}
+
+// Allocate splits the amount in proportion to the weights. The shares always add up to the amount,
+// the minor units lost to rounding go to the first shares. Without any weight nothing is allocated.
+func (a Amount) Allocate(weights []Amount) []Amount {
+	shares := make([]Amount, len(weights))
+
+	var totalWeight Amount
+	for _, weight := range weights {
+		if weight > 0 {
+			totalWeight += weight
+		}
+	}
+
+	if totalWeight == 0 {
+		return shares
+	}
+
+	var allocated Amount
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		// Truncate towards zero, the remainder is handed out below
+		shares[i] = Amount(int64(a) * int64(weight) / int64(totalWeight))
+		allocated += shares[i]
+	}
+
+	step := Amount(1)
+	if a < 0 {
+		step = -1
+	}
+
+	for i := 0; allocated != a; i = (i + 1) % len(weights) {
+		if weights[i] <= 0 {
+			continue
+		}
+		shares[i] += step
+		allocated += step
+	}
+
+	return shares
+}
+
+// Min returns the smaller amount
+func Min(a Amount, b Amount) Amount {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Value stores the amount in a decimal column
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan reads a decimal column, postgres returns numeric values as text
+func (a *Amount) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case []byte:
+		return a.scanString(string(value))
+	case string:
+		return a.scanString(value)
+	case float64:
+		*a = FromFloat(value)
+		return nil
+	case int64:
+		*a = Amount(value * scale)
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into money.Amount", src)
+	}
+}
+
+func (a *Amount) scanString(value string) error {
+	amount, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// MarshalJSON writes the amount as a decimal number, the same shape the float fields had
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON reads a decimal number or a quoted decimal
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "null" {
+		*a = 0
+		return nil
+	}
+	return a.scanString(value)
+}
+
+var currency = DEFAULT_CURRENCY
+
+// SetCurrency sets the currency of this deployment, it is called once at startup from the settings
+func SetCurrency(code string) {
+	if code != "" {
+		currency = code
+	}
+}
+
+// Currency returns the currency of this deployment
+func Currency() string {
+	return currency
+}
+
+// ToProto converts an amount to pb.Money in the currency of this deployment
+func ToProto(a Amount) *pb.Money {
+	return ToProtoIn(a, Currency())
+}
+
+// ToProtoIn converts an amount to pb.Money in the currency it was recorded in.
+// Records from before the currency was stored have no code and are in the currency of this deployment.
+func ToProtoIn(a Amount, currencyCode string) *pb.Money {
+	if currencyCode == "" {
+		currencyCode = Currency()
+	}
+	return &pb.Money{
+		MinorUnits:   int64(a),
+		CurrencyCode: currencyCode,
+	}
+}
+
+// FromProto converts pb.Money to an amount, a missing value is zero.
+// Use ValidateCurrency on request values first so amounts in another currency are rejected.
+func FromProto(m *pb.Money) Amount {
+	return Amount(m.GetMinorUnits())
+}
+
+// ValidateCurrency checks that every given value is in the currency of this deployment.
+// Values without a currency code are taken to be in that currency.
+func ValidateCurrency(values ...*pb.Money) error {
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		if err := ValidateCurrencyCode(value.CurrencyCode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCurrencyCode checks that a stored currency code is the currency of this deployment, an empty code is taken to be
+func ValidateCurrencyCode(code string) error {
+	if code != "" && code != currency {
+		return fmt.Errorf("currency %s is not supported, amounts must be in %s", code, currency)
+	}
+	return nil
+}
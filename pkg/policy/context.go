@@ -0,0 +1,69 @@
+package policy
+
+import (
+	"context"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Access is the decision of the policy for one call, RoleName is what the repositories filter lists by
+type Access struct {
+	Method   string
+	RoleName string
+	Level    string
+	Scope    Scope
+	Payload  *pb.JWTPayload
+}
+
+// Resource is the owner of the record a call reaches, only the fields the scope needs are compared
+type Resource struct {
+	CompanyID string
+	BranchID  string
+	StoreID   string
+	OwnerID   string
+}
+
+// Authorize checks the record against the scope of the caller and returns PermissionDenied when it is out of reach
+func (a *Access) Authorize(resource Resource) error {
+	var allowed bool
+
+	switch a.Scope {
+	case SCOPE_ANY:
+		return nil
+	case SCOPE_COMPANY:
+		allowed = resource.CompanyID != "" && resource.CompanyID == a.Payload.CompanyId
+	case SCOPE_BRANCH:
+		allowed = resource.BranchID != "" && resource.BranchID == a.Payload.BranchId
+	case SCOPE_STORE:
+		allowed = resource.StoreID != "" && resource.StoreID == a.Payload.StoreId
+	case SCOPE_OWNER:
+		allowed = resource.OwnerID != "" && resource.OwnerID == a.Payload.UserId
+	}
+
+	if !allowed {
+		if a.Scope == SCOPE_OWNER {
+			return status.Errorf(codes.PermissionDenied, "%s can only reach their own records through %s", a.Level, a.Method)
+		}
+		return status.Errorf(codes.PermissionDenied, "%s can only reach records of their %s through %s", a.Level, a.Scope, a.Method)
+	}
+	return nil
+}
+
+type accessContextKey struct{}
+
+// WithAccess returns a copy of ctx that carries the access decided for the call
+func WithAccess(ctx context.Context, access *Access) context.Context {
+	return context.WithValue(ctx, accessContextKey{}, access)
+}
+
+// FromContext returns the access decided by the interceptor, services read the role of the caller from here
+func FromContext(ctx context.Context) (*Access, error) {
+	access, ok := ctx.Value(accessContextKey{}).(*Access)
+	if !ok || access == nil || access.Payload == nil {
+		return nil, status.Error(codes.PermissionDenied, "request was not authorized")
+	}
+	return access, nil
+}
@@ -0,0 +1,57 @@
+package policy
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// publicMethodPrefixes are served without a token and so without a role
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+}
+
+// RoleResolver looks up the role of the caller, upstream.Client resolves it at the company service
+type RoleResolver interface {
+	GetPosRoleById(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosRoleResponse, error)
+}
+
+// UnaryServerInterceptor enforces the policy after auth.UnaryServerInterceptor verified the caller.
+// Methods without a rule are denied, allowed calls find their Access in the context.
+func UnaryServerInterceptor(policy *Policy, resolver RoleResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for _, prefix := range publicMethodPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(ctx, req)
+			}
+		}
+
+		if !policy.Covers(info.FullMethod) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission rule for %s", info.FullMethod)
+		}
+
+		identity, err := auth.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		role, err := resolver.GetPosRoleById(ctx, identity.Payload.Role, identity.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		access, err := policy.Decide(info.FullMethod, role.GetPosRole().GetRoleName())
+		if err != nil {
+			return nil, err
+		}
+		access.Payload = identity.Payload
+
+		return handler(WithAccess(ctx, access), req)
+	}
+}
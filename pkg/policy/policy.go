@@ -0,0 +1,169 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// Role levels, the role names behind them come from config.RoleSettings
+const (
+	SUPER_USER   = "super_user"
+	COMPANY_USER = "company_user"
+	BRANCH_USER  = "branch_user"
+	STORE_USER   = "store_user"
+)
+
+// Scope limits the records a role reaches through a method
+type Scope string
+
+const (
+	// SCOPE_ANY puts no limit on the record, used by creates and by lists the repositories filter themselves
+	SCOPE_ANY Scope = "any"
+	// SCOPE_COMPANY, SCOPE_BRANCH and SCOPE_STORE require the record to belong to the company, branch or store of the caller
+	SCOPE_COMPANY Scope = "company"
+	SCOPE_BRANCH  Scope = "branch"
+	SCOPE_STORE   Scope = "store"
+	// SCOPE_OWNER requires the record to belong to the caller, e.g. the drawer session of a cashier
+	SCOPE_OWNER Scope = "owner"
+)
+
+var levels = []string{SUPER_USER, COMPANY_USER, BRANCH_USER, STORE_USER}
+
+var scopes = []Scope{SCOPE_ANY, SCOPE_COMPANY, SCOPE_BRANCH, SCOPE_STORE, SCOPE_OWNER}
+
+// Rule maps every role level allowed to call a method to its scope, levels missing from the rule are denied
+type Rule map[string]Scope
+
+// Policy is the permission table of the gRPC server
+type Policy struct {
+	rules map[string]Rule
+	roles config.RoleSettings
+}
+
+// New checks every rule and returns the policy, the role names of the settings are mapped to their level
+func New(roles config.RoleSettings, rules map[string]Rule) (*Policy, error) {
+	var problems []string
+
+	for method, rule := range rules {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			problems = append(problems, fmt.Sprintf("%q is not a full gRPC method name", method))
+		}
+		if len(rule) == 0 {
+			problems = append(problems, fmt.Sprintf("%s allows no role", method))
+		}
+		for level, scope := range rule {
+			if !slices.Contains(levels, level) {
+				problems = append(problems, fmt.Sprintf("%s has an unknown role %q", method, level))
+			}
+			if !slices.Contains(scopes, scope) {
+				problems = append(problems, fmt.Sprintf("%s has an unknown scope %q for %s", method, scope, level))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("invalid policy: %s", strings.Join(problems, "; "))
+	}
+
+	return &Policy{
+		rules: rules,
+		roles: roles,
+	}, nil
+}
+
+// Load builds the policy of the settings: the default table, overridden by the rules of policy.file and then policy.rules.
+// Overrides may only name methods of the default table so a typo does not go unnoticed.
+func Load(settings *config.Settings) (*Policy, error) {
+	rules := DefaultRules()
+
+	if settings.Policy.File != "" {
+		content, err := os.ReadFile(settings.Policy.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy file %s: %w", settings.Policy.File, err)
+		}
+
+		var file config.PolicySettings
+		if err := yaml.Unmarshal(content, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse policy file %s: %w", settings.Policy.File, err)
+		}
+
+		if err := override(rules, file.Rules); err != nil {
+			return nil, fmt.Errorf("policy file %s: %w", settings.Policy.File, err)
+		}
+	}
+
+	if err := override(rules, settings.Policy.Rules); err != nil {
+		return nil, fmt.Errorf("policy.rules: %w", err)
+	}
+
+	return New(settings.Roles, rules)
+}
+
+func override(rules map[string]Rule, overrides map[string]map[string]string) error {
+	for method, levelScopes := range overrides {
+		if _, ok := rules[method]; !ok {
+			return fmt.Errorf("unknown method %s", method)
+		}
+
+		rule := Rule{}
+		for level, scope := range levelScopes {
+			rule[level] = Scope(scope)
+		}
+		rules[method] = rule
+	}
+	return nil
+}
+
+// Decide returns the access of a role name to a method, or PermissionDenied when the table does not allow it
+func (p *Policy) Decide(method string, roleName string) (*Access, error) {
+	rule, ok := p.rules[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "no permission rule for %s", method)
+	}
+
+	level := p.level(roleName)
+	scope, ok := rule[level]
+	if level == "" || !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "role %q is not allowed to call %s", roleName, method)
+	}
+
+	return &Access{
+		Method:   method,
+		RoleName: roleName,
+		Level:    level,
+		Scope:    scope,
+	}, nil
+}
+
+// Covers reports whether the table has a rule for the method
+func (p *Policy) Covers(method string) bool {
+	_, ok := p.rules[method]
+	return ok
+}
+
+func (p *Policy) level(roleName string) string {
+	switch roleName {
+	case "":
+		return ""
+	case p.roles.SuperUserRole:
+		return SUPER_USER
+	case p.roles.CompanyUserRole:
+		return COMPANY_USER
+	case p.roles.BranchUserRole:
+		return BRANCH_USER
+	case p.roles.StoreUserRole:
+		return STORE_USER
+	default:
+		return ""
+	}
+}
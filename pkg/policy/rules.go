@@ -0,0 +1,101 @@
+package policy
+
+// Lists are SCOPE_ANY, the repositories filter them by the role of the caller
+var (
+	createdByBranchOrStore = Rule{BRANCH_USER: SCOPE_ANY, STORE_USER: SCOPE_ANY}
+	createdByStore         = Rule{STORE_USER: SCOPE_ANY}
+	createdByCompany       = Rule{COMPANY_USER: SCOPE_ANY}
+	listedByAll            = Rule{COMPANY_USER: SCOPE_ANY, BRANCH_USER: SCOPE_ANY, STORE_USER: SCOPE_ANY}
+
+	// Sales records are read down to the store of the record
+	readToStore = Rule{COMPANY_USER: SCOPE_COMPANY, BRANCH_USER: SCOPE_BRANCH, STORE_USER: SCOPE_STORE}
+	// Back office records carry no store, store users read the records of their branch
+	readToBranch = Rule{COMPANY_USER: SCOPE_COMPANY, BRANCH_USER: SCOPE_BRANCH, STORE_USER: SCOPE_BRANCH}
+	// Company settings are shared by every branch and store of the company
+	readInCompany = Rule{COMPANY_USER: SCOPE_COMPANY, BRANCH_USER: SCOPE_COMPANY, STORE_USER: SCOPE_COMPANY}
+
+	changedByBranch  = Rule{BRANCH_USER: SCOPE_BRANCH}
+	changedByCompany = Rule{COMPANY_USER: SCOPE_COMPANY}
+)
+
+// DefaultRules returns a copy of the default permission table, keyed by full gRPC method name
+func DefaultRules() map[string]Rule {
+	rules := map[string]Rule{
+		"/pos.PosCashDrawerService/CreatePosCashDrawer":   createdByBranchOrStore,
+		"/pos.PosCashDrawerService/ReadPosCashDrawer":     readToStore,
+		"/pos.PosCashDrawerService/UpdatePosCashDrawer":   changedByBranch,
+		"/pos.PosCashDrawerService/DeletePosCashDrawer":   changedByBranch,
+		"/pos.PosCashDrawerService/ReadAllPosCashDrawers": listedByAll,
+		"/pos.PosCashDrawerService/OpenDrawerSession":     createdByStore,
+		// A cashier closes their own session, the branch closes any session of the branch
+		"/pos.PosCashDrawerService/CloseDrawerSession":    {BRANCH_USER: SCOPE_BRANCH, STORE_USER: SCOPE_OWNER},
+		"/pos.PosCashDrawerService/ReadAllDrawerSessions": {COMPANY_USER: SCOPE_ANY, BRANCH_USER: SCOPE_ANY},
+
+		"/pos.PosCustomerService/CreatePosCustomer":   createdByBranchOrStore,
+		"/pos.PosCustomerService/ReadPosCustomer":     readToBranch,
+		"/pos.PosCustomerService/UpdatePosCustomer":   {BRANCH_USER: SCOPE_BRANCH, STORE_USER: SCOPE_BRANCH},
+		"/pos.PosCustomerService/DeletePosCustomer":   {COMPANY_USER: SCOPE_COMPANY, BRANCH_USER: SCOPE_BRANCH},
+		"/pos.PosCustomerService/ReadAllPosCustomers": listedByAll,
+
+		"/pos.PosInvoiceService/CreatePosInvoice":   createdByBranchOrStore,
+		"/pos.PosInvoiceService/ReadPosInvoice":     readToBranch,
+		"/pos.PosInvoiceService/UpdatePosInvoice":   changedByBranch,
+		"/pos.PosInvoiceService/DeletePosInvoice":   changedByBranch,
+		"/pos.PosInvoiceService/ReadAllPosInvoices": listedByAll,
+
+		"/pos.PosOnlinePaymentService/CreatePosOnlinePayment":   createdByStore,
+		"/pos.PosOnlinePaymentService/ReadPosOnlinePayment":     readToStore,
+		"/pos.PosOnlinePaymentService/UpdatePosOnlinePayment":   changedByBranch,
+		"/pos.PosOnlinePaymentService/DeletePosOnlinePayment":   changedByBranch,
+		"/pos.PosOnlinePaymentService/ReadAllPosOnlinePayments": listedByAll,
+
+		"/pos.PosPaymentMethodService/CreatePosPaymentMethod":   createdByCompany,
+		"/pos.PosPaymentMethodService/ReadPosPaymentMethod":     readInCompany,
+		"/pos.PosPaymentMethodService/UpdatePosPaymentMethod":   changedByCompany,
+		"/pos.PosPaymentMethodService/DeletePosPaymentMethod":   changedByCompany,
+		"/pos.PosPaymentMethodService/ReadAllPosPaymentMethods": listedByAll,
+
+		"/pos.PosPromotionRuleService/CreatePosPromotionRule":   createdByCompany,
+		"/pos.PosPromotionRuleService/ReadPosPromotionRule":     readInCompany,
+		"/pos.PosPromotionRuleService/UpdatePosPromotionRule":   changedByCompany,
+		"/pos.PosPromotionRuleService/DeletePosPromotionRule":   changedByCompany,
+		"/pos.PosPromotionRuleService/ReadAllPosPromotionRules": listedByAll,
+
+		"/pos.PosTaxRateService/CreatePosTaxRate":   createdByCompany,
+		"/pos.PosTaxRateService/ReadPosTaxRate":     readInCompany,
+		"/pos.PosTaxRateService/UpdatePosTaxRate":   changedByCompany,
+		"/pos.PosTaxRateService/DeletePosTaxRate":   changedByCompany,
+		"/pos.PosTaxRateService/ReadAllPosTaxRates": listedByAll,
+
+		"/pos.PosReceiptService/CreatePosReceipt":   createdByStore,
+		"/pos.PosReceiptService/ReadPosReceipt":     readToStore,
+		"/pos.PosReceiptService/VoidPosReceipt":     {BRANCH_USER: SCOPE_BRANCH, STORE_USER: SCOPE_STORE},
+		"/pos.PosReceiptService/ReadAllPosReceipts": listedByAll,
+
+		"/pos.PosReturnService/CreatePosReturn":   {BRANCH_USER: SCOPE_BRANCH, STORE_USER: SCOPE_STORE},
+		"/pos.PosReturnService/ReadPosReturn":     readToStore,
+		"/pos.PosReturnService/UpdatePosReturn":   changedByBranch,
+		"/pos.PosReturnService/DeletePosReturn":   changedByBranch,
+		"/pos.PosReturnService/ReadAllPosReturns": listedByAll,
+
+		"/pos.PosReportService/GetDailyStoreSummary": readToStore,
+		// The series are filtered to the company or branch of the caller like the lists
+		"/pos.PosReportService/GetSalesTimeSeries": {COMPANY_USER: SCOPE_ANY, BRANCH_USER: SCOPE_ANY},
+
+		"/pos.PosSaleService/CreatePosSales":  createdByStore,
+		"/pos.PosSaleService/ReadPosSale":     readToStore,
+		"/pos.PosSaleService/UpdatePosSale":   changedByBranch,
+		"/pos.PosSaleService/DeletePosSale":   changedByBranch,
+		"/pos.PosSaleService/ReadAllPosSales": listedByAll,
+	}
+
+	for method, rule := range rules {
+		copied := make(Rule, len(rule))
+		for level, scope := range rule {
+			copied[level] = scope
+		}
+		rules[method] = copied
+	}
+
+	return rules
+}
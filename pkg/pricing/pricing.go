@@ -0,0 +1,286 @@
+// Package pricing prices a basket with the promotion rules of a company.
+// Line promotions (percentage, fixed amount, buy x get y) are applied first, the best one per line wins.
+// Bundles are then applied to the lines without a promotion, and finally the best receipt threshold
+// promotion is spread over the basket. Every discount is capped at what it is taken off.
+package pricing
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+	"github.com/google/uuid"
+)
+
+// Line is one basket line as seen by the pricing engine.
+// Gross is the list price of the line, Discount what the promotions take off it.
+// The caller sets ProductID, UnitPrice and Quantity, Price sets the rest.
+type Line struct {
+	ProductID   string
+	UnitPrice   money.Amount
+	Quantity    int
+	Gross       money.Amount
+	Discount    money.Amount
+	PromotionID string
+}
+
+// Net is what is left of the line after its discounts
+func (l *Line) Net() money.Amount {
+	return l.Gross - l.Discount
+}
+
+// Engine applies the promotions of a store to a whole basket
+type Engine struct {
+	rules []entity.PosPromotionRule
+}
+
+// NewEngine keeps the rules that are active at the given time and in scope of the store and branch
+func NewEngine(rules []entity.PosPromotionRule, storeID string, branchID string, at time.Time) *Engine {
+	var inScope []entity.PosPromotionRule
+
+	for _, rule := range rules {
+		if !rule.Active || at.Before(rule.StartDate) || at.After(rule.EndDate) {
+			continue
+		}
+
+		if rule.StoreID != nil && rule.StoreID.String() != storeID {
+			continue
+		}
+
+		if rule.BranchID != nil && rule.BranchID.String() != branchID {
+			continue
+		}
+
+		inScope = append(inScope, rule)
+	}
+
+	return &Engine{
+		rules: inScope,
+	}
+}
+
+// Price sets the gross, discount and promotion of every line and returns the receipt level promotion, if any
+func (e *Engine) Price(lines []*Line) string {
+	for _, line := range lines {
+		line.Gross = line.UnitPrice.Times(line.Quantity)
+		line.Discount = 0
+		line.PromotionID = ""
+	}
+
+	e.applyLinePromotions(lines)
+	e.applyBundles(lines)
+
+	return e.applyReceiptThreshold(lines)
+}
+
+func (e *Engine) applyLinePromotions(lines []*Line) {
+	for _, line := range lines {
+		for i := range e.rules {
+			rule := &e.rules[i]
+			if !ruleHasProduct(rule, line.ProductID) {
+				continue
+			}
+
+			var discount money.Amount
+			switch rule.RuleType {
+			case entity.PROMOTION_RULE_PERCENTAGE:
+				// A rate above 100% takes the line down to zero, not below
+				discount = money.Min(line.Gross.MulRate(rule.DiscountRate), line.Gross)
+			case entity.PROMOTION_RULE_FIXED_AMOUNT:
+				discount = money.Min(rule.DiscountAmount.Times(line.Quantity), line.Gross)
+			case entity.PROMOTION_RULE_BUY_X_GET_Y:
+				if rule.BuyQuantity <= 0 || rule.GetQuantity <= 0 {
+					continue
+				}
+				freeUnits := line.Quantity / (rule.BuyQuantity + rule.GetQuantity) * rule.GetQuantity
+				discount = line.UnitPrice.Times(freeUnits)
+			default:
+				continue
+			}
+
+			if discount > line.Discount {
+				line.Discount = discount
+				line.PromotionID = rule.PromotionRuleID.String()
+			}
+		}
+	}
+}
+
+// applyBundles sells complete sets of the bundle products at the bundle price.
+// The saving of every set is spread over its products in proportion to their unit price.
+func (e *Engine) applyBundles(lines []*Line) {
+	for i := range e.rules {
+		rule := &e.rules[i]
+		if rule.RuleType != entity.PROMOTION_RULE_BUNDLE {
+			continue
+		}
+
+		productIDs := ruleProductIDs(rule)
+		if len(productIDs) == 0 {
+			continue
+		}
+
+		// Every bundle product needs a line that no other promotion has claimed yet
+		var bundleLines []*Line
+		sets := math.MaxInt32
+		var setPrice money.Amount
+		for _, productID := range productIDs {
+			line := findFreeLine(lines, productID)
+			if line == nil {
+				sets = 0
+				break
+			}
+			bundleLines = append(bundleLines, line)
+			setPrice += line.UnitPrice
+			if line.Quantity < sets {
+				sets = line.Quantity
+			}
+		}
+
+		if sets == 0 || setPrice <= rule.BundlePrice {
+			continue
+		}
+
+		saving := (setPrice - rule.BundlePrice).Times(sets)
+		weights := make([]money.Amount, len(bundleLines))
+		for j, line := range bundleLines {
+			weights[j] = line.UnitPrice
+		}
+
+		for j, share := range saving.Allocate(weights) {
+			bundleLines[j].Discount = share
+			bundleLines[j].PromotionID = rule.PromotionRuleID.String()
+		}
+	}
+}
+
+// applyReceiptThreshold applies the best receipt promotion whose minimum subtotal the basket reaches.
+// The discount is spread over the lines in proportion to their net amount so tax stays correct per line.
+func (e *Engine) applyReceiptThreshold(lines []*Line) string {
+	var net money.Amount
+	for _, line := range lines {
+		net += line.Net()
+	}
+
+	var best *entity.PosPromotionRule
+	var bestDiscount money.Amount
+	for i := range e.rules {
+		rule := &e.rules[i]
+		if rule.RuleType != entity.PROMOTION_RULE_RECEIPT_THRESHOLD || net < rule.MinSubtotal {
+			continue
+		}
+
+		discount := money.Min(net.MulRate(rule.DiscountRate)+rule.DiscountAmount, net)
+		if discount > bestDiscount {
+			best = rule
+			bestDiscount = discount
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+
+	weights := make([]money.Amount, len(lines))
+	for i, line := range lines {
+		weights[i] = line.Net()
+	}
+
+	for i, share := range bestDiscount.Allocate(weights) {
+		lines[i].Discount += share
+	}
+
+	return best.PromotionRuleID.String()
+}
+
+// LegacyRule turns a promotion of the product service into a percentage rule.
+// It returns nil when the promotion has no discount or its dates cannot be read.
+func LegacyRule(promotion *pb.PosPromotion) *entity.PosPromotionRule {
+	if promotion == nil || promotion.DiscountRate == 0 {
+		return nil
+	}
+
+	// An empty date leaves that side of the validity window open
+	startDate, endDate := time.Time{}, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if promotion.StartDate != "" {
+		parsed, ok := parsePromotionDate(promotion.StartDate)
+		if !ok {
+			return nil
+		}
+		startDate = parsed
+	}
+
+	if promotion.EndDate != "" {
+		parsed, ok := parsePromotionDate(promotion.EndDate)
+		if !ok {
+			return nil
+		}
+		// A plain date ends at the end of that day
+		if len(promotion.EndDate) == len("2006-01-02") {
+			parsed = parsed.Add(24*time.Hour - time.Nanosecond)
+		}
+		endDate = parsed
+	}
+
+	rule := &entity.PosPromotionRule{
+		PromotionRuleID: uuid.Nil,
+		RuleType:        entity.PROMOTION_RULE_PERCENTAGE,
+		ProductIDs:      promotion.ProductId,
+		DiscountRate:    promotion.DiscountRate,
+		StartDate:       startDate,
+		EndDate:         endDate,
+		Active:          promotion.Active,
+	}
+
+	if id, err := uuid.Parse(promotion.PromotionId); err == nil {
+		rule.PromotionRuleID = id
+	}
+
+	if promotion.StoreId != "" {
+		rule.StoreID = utils.ParseUUID(promotion.StoreId)
+	}
+
+	if promotion.BranchId != "" {
+		rule.BranchID = utils.ParseUUID(promotion.BranchId)
+	}
+
+	return rule
+}
+
+func parsePromotionDate(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func ruleProductIDs(rule *entity.PosPromotionRule) []string {
+	if rule.ProductIDs == "" {
+		return nil
+	}
+	return strings.Split(rule.ProductIDs, ",")
+}
+
+func ruleHasProduct(rule *entity.PosPromotionRule, productID string) bool {
+	for _, id := range ruleProductIDs(rule) {
+		if id == productID {
+			return true
+		}
+	}
+	return false
+}
+
+func findFreeLine(lines []*Line, productID string) *Line {
+	for _, line := range lines {
+		if line.ProductID == productID && line.PromotionID == "" {
+			return line
+		}
+	}
+	return nil
+}
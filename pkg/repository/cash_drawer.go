@@ -4,13 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jinzhu/gorm"
@@ -22,38 +23,60 @@ type PosCashDrawerRepository interface {
 	ReadPosCashDrawer(drawerID string) (*pb.PosCashDrawer, error)
 	UpdatePosCashDrawer(posCashDrawer *entity.PosCashDrawer) (*pb.PosCashDrawer, error)
 	DeletePosCashDrawer(drawerID string) error
-	ReadAllPosCashDrawers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadAllPosCashDrawers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error)
 }
 
 type posCashDrawerRepository struct {
 	db    *gorm.DB
 	redis *redis.Client
+	roles config.RoleSettings
 }
 
-func NewPosCashDrawerRepository(db *gorm.DB, redis *redis.Client) PosCashDrawerRepository {
+func NewPosCashDrawerRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosCashDrawerRepository {
 	return &posCashDrawerRepository{
 		db:    db,
 		redis: redis,
+		roles: roles,
 	}
 }
 
+// CreatePosCashDrawer stores a drawer row, a row of a session is only stored while the session is still open
 func (r *posCashDrawerRepository) CreatePosCashDrawer(posCashDrawer *entity.PosCashDrawer) error {
-	result := r.db.Create(posCashDrawer)
-	if result.Error != nil {
-		return result.Error
-	}
-	return nil
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenDrawerSession(tx, posCashDrawer); err != nil {
+			return err
+		}
+		return tx.Create(posCashDrawer).Error
+	})
+}
+
+// posCashDrawerListSpec lists the drawer entry fields the back office searches and orders by
+var posCashDrawerListSpec = listSpec{
+	filters: map[string]listColumn{
+		"receipt_id":  textColumn("receipt_id"),
+		"employee_id": uuidColumn("employee_id"),
+		"session_id":  uuidColumn("session_id"),
+		"store_id":    uuidColumn("store_id"),
+		"branch_id":   uuidColumn("branch_id"),
+	},
+	sorts: map[string]string{
+		"transaction_time": "transaction_time",
+		"amount":           "amount",
+		"cash_in":          "cash_in",
+		"cash_out":         "cash_out",
+	},
+	dateColumn: "transaction_time",
+	keyColumn:  "drawer_id",
 }
 
-func (r *posCashDrawerRepository) ReadAllPosCashDrawers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+func (r *posCashDrawerRepository) ReadAllPosCashDrawers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error) {
 	var posCashDrawers []entity.PosCashDrawer
-	var totalRecords int64
 
 	query := r.db.Model(&entity.PosCashDrawer{})
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
 
 	switch roleName {
 	case companyRole:
@@ -66,27 +89,13 @@ func (r *posCashDrawerRepository) ReadAllPosCashDrawers(pagination dto.Paginatio
 		return nil, errors.New("invalid role")
 	}
 
-	if pagination.Limit > 0 && pagination.Page > 0 {
-		offset := (pagination.Page - 1) * pagination.Limit
-		query = query.Offset(offset).Limit(pagination.Limit)
-	}
-
-	query.Find(&posCashDrawers)
-	query.Count(&totalRecords)
-
-	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
-
-	return &dto.PaginationResult{
-		TotalRecords: totalRecords,
-		Records:      posCashDrawers,
-		CurrentPage:  pagination.Page,
-		TotalPages:   totalPages,
-	}, nil
+	return posCashDrawerListSpec.readPage(query, pagination, listQuery, &posCashDrawers)
 }
 
 func (r *posCashDrawerRepository) ReadPosCashDrawer(drawerID string) (*pb.PosCashDrawer, error) {
 	// Try to get the cash drawer from Redis first
 	cashDrawerData, err := r.redis.Get(context.Background(), drawerID).Result()
+	metrics.ObserveCacheLookup("cash_drawer", err)
 	if err == redis.Nil {
 		// Cash drawer not found in Redis, get from PostgreSQL
 		var posCashDrawerEntity entity.PosCashDrawer
@@ -100,9 +109,9 @@ func (r *posCashDrawerRepository) ReadPosCashDrawer(drawerID string) (*pb.PosCas
 			StoreId:         posCashDrawerEntity.StoreID.String(),
 			EmployeeId:      posCashDrawerEntity.EmployeeID.String(),
 			ReceiptId:       posCashDrawerEntity.ReceiptID,
-			CashIn:          posCashDrawerEntity.CashIn,
-			Amount:          posCashDrawerEntity.Amount,
-			CashOut:         posCashDrawerEntity.CashOut,
+			CashIn:          money.ToProto(posCashDrawerEntity.CashIn),
+			Amount:          money.ToProto(posCashDrawerEntity.Amount),
+			CashOut:         money.ToProto(posCashDrawerEntity.CashOut),
 			TransactionTime: timestamppb.New(posCashDrawerEntity.TransactionTime),
 			RoleId:          posCashDrawerEntity.RoleID.String(),
 			BranchId:        posCashDrawerEntity.BranchID.String(),
@@ -114,6 +123,10 @@ func (r *posCashDrawerRepository) ReadPosCashDrawer(drawerID string) (*pb.PosCas
 			UpdatedBy:       posCashDrawerEntity.UpdatedBy.String(),
 		}
 
+		if posCashDrawerEntity.SessionID != nil {
+			posCashDrawer.SessionId = posCashDrawerEntity.SessionID.String()
+		}
+
 		// Store the cash drawer in Redis for future queries
 		cashDrawerData, err := json.Marshal(posCashDrawerEntity)
 		if err != nil {
@@ -142,9 +155,9 @@ func (r *posCashDrawerRepository) ReadPosCashDrawer(drawerID string) (*pb.PosCas
 		StoreId:         posCashDrawerEntity.StoreID.String(),
 		EmployeeId:      posCashDrawerEntity.EmployeeID.String(),
 		ReceiptId:       posCashDrawerEntity.ReceiptID,
-		CashIn:          posCashDrawerEntity.CashIn,
-		Amount:          posCashDrawerEntity.Amount,
-		CashOut:         posCashDrawerEntity.CashOut,
+		CashIn:          money.ToProto(posCashDrawerEntity.CashIn),
+		Amount:          money.ToProto(posCashDrawerEntity.Amount),
+		CashOut:         money.ToProto(posCashDrawerEntity.CashOut),
 		TransactionTime: timestamppb.New(posCashDrawerEntity.TransactionTime),
 		RoleId:          posCashDrawerEntity.RoleID.String(),
 		BranchId:        posCashDrawerEntity.BranchID.String(),
@@ -156,6 +169,10 @@ func (r *posCashDrawerRepository) ReadPosCashDrawer(drawerID string) (*pb.PosCas
 		UpdatedBy:       posCashDrawerEntity.UpdatedBy.String(),
 	}
 
+	if posCashDrawerEntity.SessionID != nil {
+		posCashDrawer.SessionId = posCashDrawerEntity.SessionID.String()
+	}
+
 	return posCashDrawer, nil
 }
 
@@ -170,9 +187,9 @@ func (r *posCashDrawerRepository) UpdatePosCashDrawer(posCashDrawer *entity.PosC
 		StoreId:         posCashDrawer.StoreID.String(),
 		EmployeeId:      posCashDrawer.EmployeeID.String(),
 		ReceiptId:       posCashDrawer.ReceiptID,
-		CashIn:          posCashDrawer.CashIn,
-		Amount:          posCashDrawer.Amount,
-		CashOut:         posCashDrawer.CashOut,
+		CashIn:          money.ToProto(posCashDrawer.CashIn),
+		Amount:          money.ToProto(posCashDrawer.Amount),
+		CashOut:         money.ToProto(posCashDrawer.CashOut),
 		TransactionTime: timestamppb.New(posCashDrawer.TransactionTime),
 		RoleId:          posCashDrawer.RoleID.String(),
 		BranchId:        posCashDrawer.BranchID.String(),
@@ -184,6 +201,10 @@ func (r *posCashDrawerRepository) UpdatePosCashDrawer(posCashDrawer *entity.PosC
 		UpdatedBy:       posCashDrawer.UpdatedBy.String(),
 	}
 
+	if posCashDrawer.SessionID != nil {
+		updatedPosCashDrawer.SessionId = posCashDrawer.SessionID.String()
+	}
+
 	// Update the cash drawer in Redis
 	cashDrawerData, err := json.Marshal(posCashDrawer)
 	if err != nil {
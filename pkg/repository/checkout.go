@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+
+	"github.com/jinzhu/gorm"
+)
+
+type PosCheckoutRepository interface {
+	CreatePosCheckout(checkout *dto.PosCheckout) error
+}
+
+type posCheckoutRepository struct {
+	db *gorm.DB
+}
+
+func NewPosCheckoutRepository(db *gorm.DB) PosCheckoutRepository {
+	return &posCheckoutRepository{
+		db: db,
+	}
+}
+
+// CreatePosCheckout stores the receipt, the sale lines, their tender records and outgoing events in a single transaction
+func (r *posCheckoutRepository) CreatePosCheckout(checkout *dto.PosCheckout) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// The receipt lines reference the sale rows, the sales go in first
+		for _, posSale := range checkout.Sales {
+			if err := tx.Create(posSale).Error; err != nil {
+				return err
+			}
+		}
+
+		// The receipt lines and tenders are saved together with their header
+		if checkout.Receipt != nil {
+			if err := tx.Create(checkout.Receipt).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, cashDrawer := range checkout.CashDrawers {
+			if err := lockOpenDrawerSession(tx, cashDrawer); err != nil {
+				return err
+			}
+			if err := tx.Create(cashDrawer).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, invoice := range checkout.Invoices {
+			if err := tx.Create(invoice).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, onlinePayment := range checkout.OnlinePayments {
+			if err := tx.Create(onlinePayment).Error; err != nil {
+				return err
+			}
+		}
+
+		// The dashboards see the sale as soon as it is committed
+		if checkout.Receipt != nil {
+			if err := upsertPosSalesRollups(tx, receiptRollups(checkout.Receipt, 1), time.Now()); err != nil {
+				return err
+			}
+		}
+
+		// Events are stored with the sale and published later by the outbox dispatcher
+		for _, outboxMessage := range checkout.OutboxMessages {
+			if err := tx.Create(outboxMessage).Error; err != nil {
+				return err
+			}
+		}
+
+		// A retry of the same key only finds the completed response once the sale is committed
+		if checkout.IdempotencyKey != nil {
+			if err := tx.Save(checkout.IdempotencyKey).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
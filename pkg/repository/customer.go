@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jinzhu/gorm"
@@ -22,18 +22,20 @@ type PosCustomerRepository interface {
 	ReadPosCustomer(customerID string) (*pb.PosCustomer, error)
 	UpdatePosCustomer(posCustomer *entity.PosCustomer) (*pb.PosCustomer, error)
 	DeletePosCustomer(customerID string) error
-	ReadAllPosCustomers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadAllPosCustomers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error)
 }
 
 type posCustomerRepository struct {
 	db    *gorm.DB
 	redis *redis.Client
+	roles config.RoleSettings
 }
 
-func NewPosCustomerRepository(db *gorm.DB, redis *redis.Client) PosCustomerRepository {
+func NewPosCustomerRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosCustomerRepository {
 	return &posCustomerRepository{
 		db:    db,
 		redis: redis,
+		roles: roles,
 	}
 }
 
@@ -45,15 +47,35 @@ func (r *posCustomerRepository) CreatePosCustomer(posCustomer *entity.PosCustome
 	return nil
 }
 
-func (r *posCustomerRepository) ReadAllPosCustomers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+// posCustomerListSpec lists the customer fields the back office searches and orders by
+var posCustomerListSpec = listSpec{
+	filters: map[string]listColumn{
+		"email":        textColumn("email"),
+		"phone_number": textColumn("phone_number"),
+		"first_name":   textColumn("first_name"),
+		"last_name":    textColumn("last_name"),
+		"city":         textColumn("city"),
+		"country":      textColumn("country"),
+		"branch_id":    uuidColumn("branch_id"),
+	},
+	sorts: map[string]string{
+		"registration_date": "registration_date",
+		"last_name":         "last_name",
+		"first_name":        "first_name",
+		"created_at":        "created_at",
+	},
+	dateColumn: "registration_date",
+	keyColumn:  "customer_id",
+}
+
+func (r *posCustomerRepository) ReadAllPosCustomers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error) {
 	var posCustomers []entity.PosCustomer
-	var totalRecords int64
 
 	query := r.db.Model(&entity.PosCustomer{})
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
 
 	switch roleName {
 	case companyRole:
@@ -66,27 +88,13 @@ func (r *posCustomerRepository) ReadAllPosCustomers(pagination dto.Pagination, r
 		return nil, errors.New("invalid role")
 	}
 
-	if pagination.Limit > 0 && pagination.Page > 0 {
-		offset := (pagination.Page - 1) * pagination.Limit
-		query = query.Offset(offset).Limit(pagination.Limit)
-	}
-
-	query.Find(&posCustomers)
-	query.Count(&totalRecords)
-
-	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
-
-	return &dto.PaginationResult{
-		TotalRecords: totalRecords,
-		Records:      posCustomers,
-		CurrentPage:  pagination.Page,
-		TotalPages:   totalPages,
-	}, nil
+	return posCustomerListSpec.readPage(query, pagination, listQuery, &posCustomers)
 }
 
 func (r *posCustomerRepository) ReadPosCustomer(customerID string) (*pb.PosCustomer, error) {
 	// Try to get the customer from Redis first
 	customerData, err := r.redis.Get(context.Background(), customerID).Result()
+	metrics.ObserveCacheLookup("customer", err)
 	if err == redis.Nil {
 		// Customer not found in Redis, get from PostgreSQL
 		var posCustomerEntity entity.PosCustomer
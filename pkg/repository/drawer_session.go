@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosDrawerSessionRepository interface {
+	CreatePosDrawerSession(posDrawerSession *entity.PosDrawerSession) error
+	ReadPosDrawerSession(sessionID string) (*entity.PosDrawerSession, error)
+	ReadOpenPosDrawerSession(storeID string, cashierID string) (*entity.PosDrawerSession, error)
+	ClosePosDrawerSession(sessionID string, denominations []entity.PosDrawerSessionDenomination, closedBy uuid.UUID, closedAt time.Time) (*entity.PosDrawerSession, error)
+	ReadAllPosDrawerSessions(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+}
+
+type posDrawerSessionRepository struct {
+	db    *gorm.DB
+	roles config.RoleSettings
+}
+
+func NewPosDrawerSessionRepository(db *gorm.DB, roles config.RoleSettings) PosDrawerSessionRepository {
+	return &posDrawerSessionRepository{
+		db:    db,
+		roles: roles,
+	}
+}
+
+// CreatePosDrawerSession opens a session, a cashier can only have one open session per store.
+// The partial unique index on the open sessions of a store and cashier decides between concurrent opens,
+// the insert that loses the race stores nothing.
+func (r *posDrawerSessionRepository) CreatePosDrawerSession(posDrawerSession *entity.PosDrawerSession) error {
+	result := r.db.Set("gorm:insert_option", "ON CONFLICT DO NOTHING").Create(posDrawerSession)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("cashier already has an open drawer session in this store")
+	}
+
+	return nil
+}
+
+// lockOpenDrawerSession takes a share lock on the open session a cash drawer row is booked on, inside the booking transaction.
+// A close holds the session row for update, so it waits for the booking, and a booking that waited for a close
+// finds the session closed and fails instead of adding cash the close did not count.
+func lockOpenDrawerSession(tx *gorm.DB, cashDrawer *entity.PosCashDrawer) error {
+	if cashDrawer == nil || cashDrawer.SessionID == nil {
+		return nil
+	}
+
+	var posDrawerSession entity.PosDrawerSession
+	err := tx.Set("gorm:query_option", "FOR SHARE").
+		Where("session_id = ? AND status = ?", *cashDrawer.SessionID, entity.DRAWER_SESSION_OPEN).
+		First(&posDrawerSession).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return status.Error(codes.FailedPrecondition, "drawer session was closed, open a new session")
+	}
+	return err
+}
+
+func (r *posDrawerSessionRepository) ReadPosDrawerSession(sessionID string) (*entity.PosDrawerSession, error) {
+	var posDrawerSession entity.PosDrawerSession
+	if err := r.db.Preload("Denominations").Where("session_id = ?", sessionID).First(&posDrawerSession).Error; err != nil {
+		return nil, err
+	}
+	return &posDrawerSession, nil
+}
+
+// ReadOpenPosDrawerSession returns the open session of a cashier in a store, or nil when the cashier has none
+func (r *posDrawerSessionRepository) ReadOpenPosDrawerSession(storeID string, cashierID string) (*entity.PosDrawerSession, error) {
+	var posDrawerSession entity.PosDrawerSession
+	err := r.db.Where("store_id = ? AND cashier_id = ? AND status = ?", storeID, cashierID, entity.DRAWER_SESSION_OPEN).
+		First(&posDrawerSession).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &posDrawerSession, nil
+}
+
+// ClosePosDrawerSession stores the counted denominations and reconciles them with the session's cash ledger.
+// The session row is locked so no other close can run at the same time.
+func (r *posDrawerSessionRepository) ClosePosDrawerSession(sessionID string, denominations []entity.PosDrawerSessionDenomination, closedBy uuid.UUID, closedAt time.Time) (*entity.PosDrawerSession, error) {
+	var posDrawerSession entity.PosDrawerSession
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("session_id = ?", sessionID).First(&posDrawerSession).Error; err != nil {
+			return err
+		}
+
+		if posDrawerSession.Status != entity.DRAWER_SESSION_OPEN {
+			return errors.New("drawer session is already closed")
+		}
+
+		var ledger struct{ Net money.Amount }
+		if err := tx.Model(&entity.PosCashDrawer{}).
+			Select("COALESCE(SUM(cash_in - cash_out), 0) AS net").
+			Where("session_id = ?", sessionID).
+			Scan(&ledger).Error; err != nil {
+			return err
+		}
+
+		var countedCash money.Amount
+		for i := range denominations {
+			denominations[i].SessionID = posDrawerSession.SessionID
+			countedCash += denominations[i].Amount
+			if err := tx.Create(&denominations[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		posDrawerSession.Status = entity.DRAWER_SESSION_CLOSED
+		posDrawerSession.ExpectedCash = posDrawerSession.OpeningFloat + ledger.Net
+		posDrawerSession.CountedCash = countedCash
+		posDrawerSession.Variance = posDrawerSession.CountedCash - posDrawerSession.ExpectedCash
+		posDrawerSession.ClosedAt = &closedAt
+		posDrawerSession.ClosedBy = &closedBy
+		posDrawerSession.UpdatedAt = closedAt
+		posDrawerSession.UpdatedBy = closedBy
+		posDrawerSession.Denominations = denominations
+
+		return tx.Model(&entity.PosDrawerSession{}).Where("session_id = ?", sessionID).Updates(map[string]interface{}{
+			"status":        posDrawerSession.Status,
+			"expected_cash": posDrawerSession.ExpectedCash,
+			"counted_cash":  posDrawerSession.CountedCash,
+			"variance":      posDrawerSession.Variance,
+			"closed_at":     closedAt,
+			"closed_by":     closedBy,
+			"updated_at":    closedAt,
+			"updated_by":    closedBy,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &posDrawerSession, nil
+}
+
+// posDrawerSessionListSpec pages the drawer sessions, the last opened first when they are numbered
+var posDrawerSessionListSpec = listSpec{
+	dateColumn: "opened_at",
+	keyColumn:  "session_id",
+}
+
+func (r *posDrawerSessionRepository) ReadAllPosDrawerSessions(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+	var posDrawerSessions []entity.PosDrawerSession
+
+	query := r.db.Model(&entity.PosDrawerSession{})
+
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	return posDrawerSessionListSpec.readPage(query.Preload("Denominations"), pagination, &pb.ListQuery{Descending: true}, &posDrawerSessions)
+}
+
+// PosDrawerSessionToProto converts a session with its counted denominations to pb.PosDrawerSession
+func PosDrawerSessionToProto(posDrawerSession *entity.PosDrawerSession) *pb.PosDrawerSession {
+	pbPosDrawerSession := &pb.PosDrawerSession{
+		SessionId:    posDrawerSession.SessionID.String(),
+		StoreId:      posDrawerSession.StoreID.String(),
+		BranchId:     posDrawerSession.BranchID.String(),
+		CompanyId:    posDrawerSession.CompanyID.String(),
+		CashierId:    posDrawerSession.CashierID.String(),
+		Status:       posDrawerSession.Status,
+		OpeningFloat: money.ToProto(posDrawerSession.OpeningFloat),
+		ExpectedCash: money.ToProto(posDrawerSession.ExpectedCash),
+		CountedCash:  money.ToProto(posDrawerSession.CountedCash),
+		Variance:     money.ToProto(posDrawerSession.Variance),
+		OpenedAt:     timestamppb.New(posDrawerSession.OpenedAt),
+		CreatedAt:    timestamppb.New(posDrawerSession.CreatedAt),
+		CreatedBy:    posDrawerSession.CreatedBy.String(),
+		UpdatedAt:    timestamppb.New(posDrawerSession.UpdatedAt),
+		UpdatedBy:    posDrawerSession.UpdatedBy.String(),
+	}
+
+	if posDrawerSession.ClosedAt != nil {
+		pbPosDrawerSession.ClosedAt = timestamppb.New(*posDrawerSession.ClosedAt)
+	}
+
+	if posDrawerSession.ClosedBy != nil {
+		pbPosDrawerSession.ClosedBy = posDrawerSession.ClosedBy.String()
+	}
+
+	for _, denomination := range posDrawerSession.Denominations {
+		pbPosDrawerSession.Denominations = append(pbPosDrawerSession.Denominations, &pb.PosDenominationCount{
+			Denomination: money.ToProto(denomination.Denomination),
+			Count:        int32(denomination.Count),
+			Amount:       money.ToProto(denomination.Amount),
+		})
+	}
+
+	return pbPosDrawerSession
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+
+	"github.com/jinzhu/gorm"
+)
+
+type PosIdempotencyKeyRepository interface {
+	ReservePosIdempotencyKey(key *entity.PosIdempotencyKey, abandonedBefore time.Time) (*entity.PosIdempotencyKey, bool, error)
+	ReleasePosIdempotencyKey(key *entity.PosIdempotencyKey) error
+	DeleteExpiredPosIdempotencyKeys(before time.Time) (int64, error)
+}
+
+type posIdempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewPosIdempotencyKeyRepository(db *gorm.DB) PosIdempotencyKeyRepository {
+	return &posIdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// ReservePosIdempotencyKey stores key in progress unless the user already sent it.
+// An expired key, or one left in progress since before abandonedBefore, is taken over.
+// It reports false with the stored key when the key is held by another call or was completed.
+func (r *posIdempotencyKeyRepository) ReservePosIdempotencyKey(key *entity.PosIdempotencyKey, abandonedBefore time.Time) (*entity.PosIdempotencyKey, bool, error) {
+	var stored entity.PosIdempotencyKey
+	reserved := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Set("gorm:insert_option", "ON CONFLICT DO NOTHING").Create(key)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 1 {
+			reserved = true
+			return nil
+		}
+
+		// Concurrent retries of the same key wait here for each other
+		err := tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("user_id = ? AND idempotency_key = ?", key.UserID, key.IdempotencyKey).
+			First(&stored).Error
+		if err != nil {
+			return err
+		}
+
+		abandoned := stored.Status == entity.IDEMPOTENCY_STATUS_IN_PROGRESS && stored.UpdatedAt.Before(abandonedBefore)
+		if stored.ExpiresAt.After(time.Now()) && !abandoned {
+			return nil
+		}
+
+		reserved = true
+		return tx.Save(key).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if reserved {
+		return key, true, nil
+	}
+	return &stored, false, nil
+}
+
+// ReleasePosIdempotencyKey frees a key whose checkout failed so the till can retry it
+func (r *posIdempotencyKeyRepository) ReleasePosIdempotencyKey(key *entity.PosIdempotencyKey) error {
+	return r.db.
+		Where("user_id = ? AND idempotency_key = ? AND status = ?", key.UserID, key.IdempotencyKey, entity.IDEMPOTENCY_STATUS_IN_PROGRESS).
+		Delete(&entity.PosIdempotencyKey{}).Error
+}
+
+// DeleteExpiredPosIdempotencyKeys removes the keys that are no longer replayed
+func (r *posIdempotencyKeyRepository) DeleteExpiredPosIdempotencyKeys(before time.Time) (int64, error) {
+	result := r.db.Where("expires_at < ?", before).Delete(&entity.PosIdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
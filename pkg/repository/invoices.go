@@ -4,13 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jinzhu/gorm"
@@ -22,18 +23,20 @@ type PosInvoiceRepository interface {
 	ReadPosInvoice(invoiceID string) (*pb.PosInvoice, error)
 	UpdatePosInvoice(posInvoice *entity.PosInvoice) (*pb.PosInvoice, error)
 	DeletePosInvoice(invoiceID string) error
-	ReadAllPosInvoices(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadAllPosInvoices(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error)
 }
 
 type posInvoiceRepository struct {
 	db    *gorm.DB
 	redis *redis.Client
+	roles config.RoleSettings
 }
 
-func NewPosInvoiceRepository(db *gorm.DB, redis *redis.Client) PosInvoiceRepository {
+func NewPosInvoiceRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosInvoiceRepository {
 	return &posInvoiceRepository{
 		db:    db,
 		redis: redis,
+		roles: roles,
 	}
 }
 
@@ -45,15 +48,28 @@ func (r *posInvoiceRepository) CreatePosInvoice(posInvoice *entity.PosInvoice) e
 	return nil
 }
 
-func (r *posInvoiceRepository) ReadAllPosInvoices(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+// posInvoiceListSpec lists the invoice fields the back office searches and orders by
+var posInvoiceListSpec = listSpec{
+	filters: map[string]listColumn{
+		"receipt_id": textColumn("receipt_id"),
+		"branch_id":  uuidColumn("branch_id"),
+	},
+	sorts: map[string]string{
+		"date":   `"date"`,
+		"amount": "amount",
+	},
+	dateColumn: `"date"`,
+	keyColumn:  "invoice_id",
+}
+
+func (r *posInvoiceRepository) ReadAllPosInvoices(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error) {
 	var posInvoices []entity.PosInvoice
-	var totalRecords int64
 
 	query := r.db.Model(&entity.PosInvoice{})
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
 
 	switch roleName {
 	case companyRole:
@@ -61,32 +77,18 @@ func (r *posInvoiceRepository) ReadAllPosInvoices(pagination dto.Pagination, rol
 	case branchRole:
 		query = query.Where("branch_id = ?", jwtPayload.BranchId)
 	case storeRole:
-		return nil, errors.New("store users are not allowed to retrieve invoices")
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
 	default:
 		return nil, errors.New("invalid role")
 	}
 
-	if pagination.Limit > 0 && pagination.Page > 0 {
-		offset := (pagination.Page - 1) * pagination.Limit
-		query = query.Offset(offset).Limit(pagination.Limit)
-	}
-
-	query.Find(&posInvoices)
-	query.Count(&totalRecords)
-
-	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
-
-	return &dto.PaginationResult{
-		TotalRecords: totalRecords,
-		Records:      posInvoices,
-		CurrentPage:  pagination.Page,
-		TotalPages:   totalPages,
-	}, nil
+	return posInvoiceListSpec.readPage(query, pagination, listQuery, &posInvoices)
 }
 
 func (r *posInvoiceRepository) ReadPosInvoice(invoiceID string) (*pb.PosInvoice, error) {
 	// Try to get the invoice from Redis first
 	invoiceData, err := r.redis.Get(context.Background(), invoiceID).Result()
+	metrics.ObserveCacheLookup("invoice", err)
 	if err == redis.Nil {
 		// Invoice not found in Redis, get from PostgreSQL
 		var posInvoiceEntity entity.PosInvoice
@@ -99,9 +101,9 @@ func (r *posInvoiceRepository) ReadPosInvoice(invoiceID string) (*pb.PosInvoice,
 			InvoiceId: posInvoiceEntity.InvoiceID.String(),
 			ReceiptId: posInvoiceEntity.ReceiptID,
 			Date:      timestamppb.New(posInvoiceEntity.Date),
-			Amount:    posInvoiceEntity.Amount,
-			Discounts: posInvoiceEntity.Discounts,
-			Taxes:     posInvoiceEntity.Taxes,
+			Amount:    money.ToProto(posInvoiceEntity.Amount),
+			Discounts: money.ToProto(posInvoiceEntity.Discounts),
+			Taxes:     money.ToProto(posInvoiceEntity.Taxes),
 			BranchId:  posInvoiceEntity.BranchID.String(),
 			CompanyId: posInvoiceEntity.CompanyID.String(),
 			CreatedAt: timestamppb.New(posInvoiceEntity.CreatedAt),
@@ -137,9 +139,9 @@ func (r *posInvoiceRepository) ReadPosInvoice(invoiceID string) (*pb.PosInvoice,
 		InvoiceId: posInvoiceEntity.InvoiceID.String(),
 		ReceiptId: posInvoiceEntity.ReceiptID,
 		Date:      timestamppb.New(posInvoiceEntity.Date),
-		Amount:    posInvoiceEntity.Amount,
-		Discounts: posInvoiceEntity.Discounts,
-		Taxes:     posInvoiceEntity.Taxes,
+		Amount:    money.ToProto(posInvoiceEntity.Amount),
+		Discounts: money.ToProto(posInvoiceEntity.Discounts),
+		Taxes:     money.ToProto(posInvoiceEntity.Taxes),
 		BranchId:  posInvoiceEntity.BranchID.String(),
 		CompanyId: posInvoiceEntity.CompanyID.String(),
 		CreatedAt: timestamppb.New(posInvoiceEntity.CreatedAt),
@@ -161,9 +163,9 @@ func (r *posInvoiceRepository) UpdatePosInvoice(posInvoice *entity.PosInvoice) (
 		InvoiceId: posInvoice.InvoiceID.String(),
 		ReceiptId: posInvoice.ReceiptID,
 		Date:      timestamppb.New(posInvoice.Date),
-		Amount:    posInvoice.Amount,
-		Discounts: posInvoice.Discounts,
-		Taxes:     posInvoice.Taxes,
+		Amount:    money.ToProto(posInvoice.Amount),
+		Discounts: money.ToProto(posInvoice.Discounts),
+		Taxes:     money.ToProto(posInvoice.Taxes),
 		BranchId:  posInvoice.BranchID.String(),
 		CompanyId: posInvoice.CompanyID.String(),
 		CreatedAt: timestamppb.New(posInvoice.CreatedAt),
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"sort"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// listSpec is what a ReadAll accepts from a ListQuery.
+// Filter fields and sort keys are looked up in fixed maps of columns, only the values are bound as parameters,
+// so nothing of the request ends up in the SQL text.
+type listSpec struct {
+	filters    map[string]listColumn
+	sorts      map[string]string
+	dateColumn string // filtered by from and to, the order when no sort key is given
+	keyColumn  string // breaks ties between records with the same sort value
+}
+
+type listColumn struct {
+	column string
+	uuid   bool
+}
+
+func textColumn(column string) listColumn {
+	return listColumn{column: column}
+}
+
+func uuidColumn(column string) listColumn {
+	return listColumn{column: column, uuid: true}
+}
+
+// filter narrows query to the filters and date range of listQuery, unknown fields and malformed values are rejected with InvalidArgument
+func (s listSpec) filter(query *gorm.DB, listQuery *pb.ListQuery) (*gorm.DB, error) {
+	// Filters are applied in name order so the same request builds the same SQL
+	names := make([]string, 0, len(listQuery.GetFilters()))
+	for name := range listQuery.GetFilters() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		filter, ok := s.filters[name]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot filter by %q", name)
+		}
+
+		value := listQuery.GetFilters()[name]
+		if filter.uuid {
+			if _, err := uuid.Parse(value); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "filter %s must be a UUID, got %q", name, value)
+			}
+		}
+		query = query.Where(filter.column+" = ?", value)
+	}
+
+	from, to := listQuery.GetFrom(), listQuery.GetTo()
+	if from != nil && to != nil && !to.AsTime().After(from.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+	if from != nil {
+		query = query.Where(s.dateColumn+" >= ?", from.AsTime())
+	}
+	if to != nil {
+		query = query.Where(s.dateColumn+" < ?", to.AsTime())
+	}
+
+	return query, nil
+}
+
+// sortColumn is the column of the sort key of listQuery, the date column when it has none
+func (s listSpec) sortColumn(listQuery *pb.ListQuery) (string, error) {
+	sortBy := listQuery.GetSortBy()
+	if sortBy == "" {
+		return s.dateColumn, nil
+	}
+
+	column, ok := s.sorts[sortBy]
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "cannot sort by %q", sortBy)
+	}
+	return column, nil
+}
+
+func direction(listQuery *pb.ListQuery) string {
+	if listQuery.GetDescending() {
+		return " DESC"
+	}
+	return " ASC"
+}
@@ -4,13 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -23,18 +24,20 @@ type PosOnlinePaymentRepository interface {
 	ReadPosOnlinePayment(paymentID string) (*pb.PosOnlinePayment, error)
 	UpdatePosOnlinePayment(posOnlinePayment *entity.PosOnlinePayment) (*pb.PosOnlinePayment, error)
 	DeletePosOnlinePayment(paymentID string) error
-	ReadAllPosOnlinePayments(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadAllPosOnlinePayments(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error)
 }
 
 type posOnlinePaymentRepository struct {
 	db    *gorm.DB
 	redis *redis.Client
+	roles config.RoleSettings
 }
 
-func NewPosOnlinePaymentRepository(db *gorm.DB, redis *redis.Client) PosOnlinePaymentRepository {
+func NewPosOnlinePaymentRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosOnlinePaymentRepository {
 	return &posOnlinePaymentRepository{
 		db:    db,
 		redis: redis,
+		roles: roles,
 	}
 }
 
@@ -49,6 +52,7 @@ func (r *posOnlinePaymentRepository) CreatePosOnlinePayment(posOnlinePayment *en
 func (r *posOnlinePaymentRepository) ReadPosOnlinePayment(paymentID string) (*pb.PosOnlinePayment, error) {
 	// Try to get the online payment from Redis first
 	onlinePaymentData, err := r.redis.Get(context.Background(), paymentID).Result()
+	metrics.ObserveCacheLookup("online_payment", err)
 	if err == redis.Nil {
 		// Online payment not found in Redis, get from PostgreSQL
 		var posOnlinePaymentEntity entity.PosOnlinePayment
@@ -63,7 +67,7 @@ func (r *posOnlinePaymentRepository) ReadPosOnlinePayment(paymentID string) (*pb
 			EmployeeId:    posOnlinePaymentEntity.EmployeeID.String(),
 			PaymentDate:   timestamppb.New(posOnlinePaymentEntity.PaymentDate),
 			ReceiptId:     posOnlinePaymentEntity.ReceiptID,
-			Amount:        posOnlinePaymentEntity.Amount,
+			Amount:        money.ToProto(posOnlinePaymentEntity.Amount),
 			PaymentMethod: posOnlinePaymentEntity.PaymentMethod.String(),
 			RoleId:        posOnlinePaymentEntity.RoleID.String(),
 			BranchId:      posOnlinePaymentEntity.BranchID.String(),
@@ -103,7 +107,7 @@ func (r *posOnlinePaymentRepository) ReadPosOnlinePayment(paymentID string) (*pb
 		EmployeeId:    posOnlinePaymentEntity.EmployeeID.String(),
 		PaymentDate:   timestamppb.New(posOnlinePaymentEntity.PaymentDate),
 		ReceiptId:     posOnlinePaymentEntity.ReceiptID, // Added missing field
-		Amount:        posOnlinePaymentEntity.Amount,
+		Amount:        money.ToProto(posOnlinePaymentEntity.Amount),
 		PaymentMethod: posOnlinePaymentEntity.PaymentMethod.String(),
 		RoleId:        posOnlinePaymentEntity.RoleID.String(),
 		BranchId:      posOnlinePaymentEntity.BranchID.String(),
@@ -129,7 +133,7 @@ func (r *posOnlinePaymentRepository) UpdatePosOnlinePayment(posOnlinePayment *en
 		EmployeeId:    posOnlinePayment.EmployeeID.String(),
 		PaymentDate:   timestamppb.New(posOnlinePayment.PaymentDate),
 		ReceiptId:     posOnlinePayment.ReceiptID,
-		Amount:        posOnlinePayment.Amount,
+		Amount:        money.ToProto(posOnlinePayment.Amount),
 		PaymentMethod: posOnlinePayment.PaymentMethod.String(),
 		RoleId:        posOnlinePayment.RoleID.String(),
 		BranchId:      posOnlinePayment.BranchID.String(),
@@ -167,15 +171,31 @@ func (r *posOnlinePaymentRepository) DeletePosOnlinePayment(paymentID string) er
 	return nil
 }
 
-func (r *posOnlinePaymentRepository) ReadAllPosOnlinePayments(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+// posOnlinePaymentListSpec lists the online payment fields the back office searches and orders by
+var posOnlinePaymentListSpec = listSpec{
+	filters: map[string]listColumn{
+		"receipt_id":     textColumn("receipt_id"),
+		"employee_id":    uuidColumn("employee_id"),
+		"payment_method": uuidColumn("payment_method"),
+		"store_id":       uuidColumn("store_id"),
+		"branch_id":      uuidColumn("branch_id"),
+	},
+	sorts: map[string]string{
+		"payment_date": "payment_date",
+		"amount":       "amount",
+	},
+	dateColumn: "payment_date",
+	keyColumn:  "payment_id",
+}
+
+func (r *posOnlinePaymentRepository) ReadAllPosOnlinePayments(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error) {
 	var posOnlinePayments []entity.PosOnlinePayment
-	var totalRecords int64
 
 	query := r.db.Model(&entity.PosOnlinePayment{})
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
 
 	switch roleName {
 	case companyRole:
@@ -188,20 +208,5 @@ func (r *posOnlinePaymentRepository) ReadAllPosOnlinePayments(pagination dto.Pag
 		return nil, errors.New("invalid role")
 	}
 
-	if pagination.Limit > 0 && pagination.Page > 0 {
-		offset := (pagination.Page - 1) * pagination.Limit
-		query = query.Offset(offset).Limit(pagination.Limit)
-	}
-
-	query.Find(&posOnlinePayments)
-	query.Count(&totalRecords)
-
-	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
-
-	return &dto.PaginationResult{
-		TotalRecords: totalRecords,
-		Records:      posOnlinePayments,
-		CurrentPage:  pagination.Page,
-		TotalPages:   totalPages,
-	}, nil
+	return posOnlinePaymentListSpec.readPage(query, pagination, listQuery, &posOnlinePayments)
 }
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+
+	"github.com/jinzhu/gorm"
+)
+
+type PosOutboxRepository interface {
+	ClaimPosOutboxMessages(limit int, lease time.Duration) ([]entity.PosOutboxMessage, error)
+	MarkPosOutboxMessagePublished(messageID string) error
+	MarkPosOutboxMessageFailed(messageID string, attempts int, lastError string, nextAttemptAt time.Time) error
+	MarkPosOutboxMessageDead(messageID string, attempts int, lastError string) error
+}
+
+type posOutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewPosOutboxRepository(db *gorm.DB) PosOutboxRepository {
+	return &posOutboxRepository{
+		db: db,
+	}
+}
+
+// ClaimPosOutboxMessages picks the pending messages that are due and pushes their next attempt
+// past the lease, so another dispatcher instance does not publish them at the same time
+func (r *posOutboxRepository) ClaimPosOutboxMessages(limit int, lease time.Duration) ([]entity.PosOutboxMessage, error) {
+	var messages []entity.PosOutboxMessage
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+			Where("status = ? AND next_attempt_at <= ?", entity.OUTBOX_STATUS_PENDING, now).
+			Order("created_at").
+			Limit(limit).
+			Find(&messages).Error
+		if err != nil {
+			return err
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		messageIDs := make([]string, len(messages))
+		for i, message := range messages {
+			messageIDs[i] = message.MessageID.String()
+		}
+
+		return tx.Model(&entity.PosOutboxMessage{}).
+			Where("message_id IN (?)", messageIDs).
+			Updates(map[string]interface{}{"next_attempt_at": now.Add(lease), "updated_at": now}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (r *posOutboxRepository) MarkPosOutboxMessagePublished(messageID string) error {
+	now := time.Now()
+	return r.db.Model(&entity.PosOutboxMessage{}).
+		Where("message_id = ?", messageID).
+		Updates(map[string]interface{}{"status": entity.OUTBOX_STATUS_PUBLISHED, "published_at": now, "updated_at": now}).Error
+}
+
+func (r *posOutboxRepository) MarkPosOutboxMessageFailed(messageID string, attempts int, lastError string, nextAttemptAt time.Time) error {
+	return r.db.Model(&entity.PosOutboxMessage{}).
+		Where("message_id = ?", messageID).
+		Updates(map[string]interface{}{"attempts": attempts, "last_error": lastError, "next_attempt_at": nextAttemptAt, "updated_at": time.Now()}).Error
+}
+
+func (r *posOutboxRepository) MarkPosOutboxMessageDead(messageID string, attempts int, lastError string) error {
+	return r.db.Model(&entity.PosOutboxMessage{}).
+		Where("message_id = ?", messageID).
+		Updates(map[string]interface{}{"status": entity.OUTBOX_STATUS_DEAD, "attempts": attempts, "last_error": lastError, "updated_at": time.Now()}).Error
+}
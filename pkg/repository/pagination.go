@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Token pages are ordered by creation time and then by key, a record added meanwhile does not shift the next page
+const pageTokenColumn = "created_at"
+
+// pageToken points after the last record of a page, it is handed out base64 encoded and opaque to the caller
+type pageToken struct {
+	CreatedAt  time.Time `json:"c"`
+	Key        string    `json:"k"`
+	Descending bool      `json:"d"`
+	Query      string    `json:"q"` // fingerprint of the filters the token was issued for
+}
+
+// readPage counts the records matched by query and reads one page of them into records, a pointer to a slice of entities.
+// With a limit and a page number the page is read with an offset, with a limit only the page starts after pagination.PageToken
+// and the result carries the token of the next page. Without a limit every record is read.
+// The pages after the first token page are not counted exactly, the caller has the count of the first page.
+func (s listSpec) readPage(query *gorm.DB, pagination dto.Pagination, listQuery *pb.ListQuery, records interface{}) (*dto.PaginationResult, error) {
+	query, err := s.filter(query, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.PaginationResult{CurrentPage: pagination.Page}
+
+	// The count runs on the filtered query before it is ordered and limited
+	switch {
+	case pagination.EstimateCount:
+		result.TotalRecords, err = estimateCount(query)
+		result.CountEstimated = true
+	case pagination.PageToken == "":
+		err = query.Count(&result.TotalRecords).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case pagination.Limit < 0 || pagination.Page < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit and page cannot be negative")
+
+	case pagination.PageToken != "" && pagination.Page > 0:
+		return nil, status.Error(codes.InvalidArgument, "page token and page cannot be combined")
+
+	case pagination.Limit == 0:
+		if pagination.PageToken != "" {
+			return nil, status.Error(codes.InvalidArgument, "page token needs a limit")
+		}
+
+		sortColumn, err := s.sortColumn(listQuery)
+		if err != nil {
+			return nil, err
+		}
+		if err := query.Order(sortColumn + direction(listQuery)).Order(s.keyColumn + direction(listQuery)).Find(records).Error; err != nil {
+			return nil, err
+		}
+
+	case pagination.Page > 0:
+		sortColumn, err := s.sortColumn(listQuery)
+		if err != nil {
+			return nil, err
+		}
+		err = query.Order(sortColumn + direction(listQuery)).Order(s.keyColumn + direction(listQuery)).
+			Offset((pagination.Page - 1) * pagination.Limit).
+			Limit(pagination.Limit).
+			Find(records).Error
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		result.NextPageToken, err = s.readTokenPage(query, pagination, listQuery, records)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Records = reflect.ValueOf(records).Elem().Interface()
+	if pagination.Limit > 0 {
+		result.TotalPages = int(math.Ceil(float64(result.TotalRecords) / float64(pagination.Limit)))
+	} else if result.TotalRecords > 0 {
+		result.TotalPages = 1
+	}
+	return result, nil
+}
+
+// readTokenPage reads the page after the token of pagination and returns the token of the page after it
+func (s listSpec) readTokenPage(query *gorm.DB, pagination dto.Pagination, listQuery *pb.ListQuery, records interface{}) (string, error) {
+	if sortBy := listQuery.GetSortBy(); sortBy != "" && s.sorts[sortBy] != pageTokenColumn {
+		return "", status.Errorf(codes.InvalidArgument, "token pages are ordered by %s, sorting by %q needs a page number", pageTokenColumn, sortBy)
+	}
+
+	fingerprint, err := queryFingerprint(listQuery)
+	if err != nil {
+		return "", err
+	}
+
+	if pagination.PageToken != "" {
+		token, err := decodePageToken(pagination.PageToken)
+		if err != nil {
+			return "", err
+		}
+		if token.Descending != listQuery.GetDescending() || token.Query != fingerprint {
+			return "", status.Error(codes.InvalidArgument, "page token was issued for different filters or order")
+		}
+
+		comparison := ">"
+		if token.Descending {
+			comparison = "<"
+		}
+		query = query.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", pageTokenColumn, s.keyColumn, comparison), token.CreatedAt, token.Key)
+	}
+
+	// One record more than the limit tells whether there is a next page
+	err = query.Order(pageTokenColumn + direction(listQuery)).Order(s.keyColumn + direction(listQuery)).
+		Limit(pagination.Limit + 1).
+		Find(records).Error
+	if err != nil {
+		return "", err
+	}
+
+	page := reflect.ValueOf(records).Elem()
+	if page.Len() <= pagination.Limit {
+		return "", nil
+	}
+	page.Set(page.Slice(0, pagination.Limit))
+
+	last := page.Index(pagination.Limit - 1).Addr().Interface()
+	createdAt, ok := query.NewScope(last).FieldByName("CreatedAt")
+	if !ok {
+		return "", fmt.Errorf("%T has no CreatedAt to page by", last)
+	}
+
+	return encodePageToken(pageToken{
+		CreatedAt:  createdAt.Field.Interface().(time.Time),
+		Key:        fmt.Sprint(query.NewScope(last).PrimaryKeyValue()),
+		Descending: listQuery.GetDescending(),
+		Query:      fingerprint,
+	})
+}
+
+// estimateCount asks the planner how many records query matches, counting millions of sales takes seconds
+func estimateCount(query *gorm.DB) (int64, error) {
+	var plan string
+	if err := query.New().Raw("EXPLAIN (FORMAT JSON) ?", query.QueryExpr()).Row().Scan(&plan); err != nil {
+		return 0, err
+	}
+
+	var explained []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explained); err != nil {
+		return 0, err
+	}
+	if len(explained) == 0 {
+		return 0, fmt.Errorf("empty query plan")
+	}
+	return int64(explained[0].Plan.Rows), nil
+}
+
+func encodePageToken(token pageToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(value string) (pageToken, error) {
+	var token pageToken
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return token, status.Error(codes.InvalidArgument, "malformed page token")
+	}
+	if err := json.Unmarshal(data, &token); err != nil || token.Key == "" {
+		return token, status.Error(codes.InvalidArgument, "malformed page token")
+	}
+	return token, nil
+}
+
+// queryFingerprint ties a page token to the filters it was issued for, a token reused with other filters would skip records
+func queryFingerprint(listQuery *pb.ListQuery) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(listQuery)
+	if err != nil || len(data) == 0 {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
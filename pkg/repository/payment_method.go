@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jinzhu/gorm"
@@ -28,12 +28,14 @@ type PosPaymentMethodRepository interface {
 type posPaymentMethodRepository struct {
 	db    *gorm.DB
 	redis *redis.Client
+	roles config.RoleSettings
 }
 
-func NewPosPaymentMethodRepository(db *gorm.DB, redis *redis.Client) PosPaymentMethodRepository {
+func NewPosPaymentMethodRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosPaymentMethodRepository {
 	return &posPaymentMethodRepository{
 		db:    db,
 		redis: redis,
+		roles: roles,
 	}
 }
 
@@ -48,6 +50,7 @@ func (r *posPaymentMethodRepository) CreatePosPaymentMethod(posPaymentMethod *en
 func (r *posPaymentMethodRepository) ReadPosPaymentMethod(paymentMethodID string) (*pb.PosPaymentMethod, error) {
 	// Try to get the payment method from Redis first
 	paymentMethodData, err := r.redis.Get(context.Background(), paymentMethodID).Result()
+	metrics.ObserveCacheLookup("payment_method", err)
 	if err == redis.Nil {
 		// Payment method not found in Redis, get from PostgreSQL
 		var posPaymentMethodEntity entity.PosPaymentMethod
@@ -145,15 +148,20 @@ func (r *posPaymentMethodRepository) DeletePosPaymentMethod(paymentMethodID stri
 	return nil
 }
 
+// posPaymentMethodListSpec pages the payment methods in the order they were created
+var posPaymentMethodListSpec = listSpec{
+	dateColumn: "created_at",
+	keyColumn:  "payment_method_id",
+}
+
 func (r *posPaymentMethodRepository) ReadAllPosPaymentMethods(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
 	var posPaymentMethods []entity.PosPaymentMethod
-	var totalRecords int64
 
 	query := r.db.Model(&entity.PosPaymentMethod{})
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
 
 	switch roleName {
 	case companyRole:
@@ -166,20 +174,5 @@ func (r *posPaymentMethodRepository) ReadAllPosPaymentMethods(pagination dto.Pag
 		return nil, errors.New("invalid role")
 	}
 
-	if pagination.Limit > 0 && pagination.Page > 0 {
-		offset := (pagination.Page - 1) * pagination.Limit
-		query = query.Offset(offset).Limit(pagination.Limit)
-	}
-
-	query.Find(&posPaymentMethods)
-	query.Count(&totalRecords)
-
-	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
-
-	return &dto.PaginationResult{
-		TotalRecords: totalRecords,
-		Records:      posPaymentMethods,
-		CurrentPage:  pagination.Page,
-		TotalPages:   totalPages,
-	}, nil
+	return posPaymentMethodListSpec.readPage(query, pagination, nil, &posPaymentMethods)
 }
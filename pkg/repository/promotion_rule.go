@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosPromotionRuleRepository interface {
+	CreatePosPromotionRule(posPromotionRule *entity.PosPromotionRule) error
+	ReadPosPromotionRule(promotionRuleID string) (*pb.PosPromotionRule, error)
+	UpdatePosPromotionRule(posPromotionRule *entity.PosPromotionRule) (*pb.PosPromotionRule, error)
+	DeletePosPromotionRule(promotionRuleID string) error
+	ReadAllPosPromotionRules(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadActivePosPromotionRules(companyID string, at time.Time) ([]entity.PosPromotionRule, error)
+}
+
+type posPromotionRuleRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+	roles config.RoleSettings
+}
+
+func NewPosPromotionRuleRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosPromotionRuleRepository {
+	return &posPromotionRuleRepository{
+		db:    db,
+		redis: redis,
+		roles: roles,
+	}
+}
+
+func (r *posPromotionRuleRepository) CreatePosPromotionRule(posPromotionRule *entity.PosPromotionRule) error {
+	result := r.db.Create(posPromotionRule)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *posPromotionRuleRepository) ReadPosPromotionRule(promotionRuleID string) (*pb.PosPromotionRule, error) {
+	// Try to get the promotion rule from Redis first
+	promotionRuleData, err := r.redis.Get(context.Background(), promotionRuleID).Result()
+	metrics.ObserveCacheLookup("promotion_rule", err)
+	if err == redis.Nil {
+		// Promotion rule not found in Redis, get from PostgreSQL
+		var posPromotionRuleEntity entity.PosPromotionRule
+		if err := r.db.Where("promotion_rule_id = ?", promotionRuleID).First(&posPromotionRuleEntity).Error; err != nil {
+			return nil, err
+		}
+
+		// Store the promotion rule in Redis for future queries
+		promotionRuleData, err := json.Marshal(posPromotionRuleEntity)
+		if err != nil {
+			return nil, err
+		}
+		err = r.redis.Set(context.Background(), promotionRuleID, promotionRuleData, 7*24*time.Hour).Err()
+		if err != nil {
+			return nil, err
+		}
+
+		return PosPromotionRuleToProto(&posPromotionRuleEntity), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Promotion rule found in Redis, unmarshal the data
+	var posPromotionRuleEntity entity.PosPromotionRule
+	err = json.Unmarshal([]byte(promotionRuleData), &posPromotionRuleEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return PosPromotionRuleToProto(&posPromotionRuleEntity), nil
+}
+
+func (r *posPromotionRuleRepository) UpdatePosPromotionRule(posPromotionRule *entity.PosPromotionRule) (*pb.PosPromotionRule, error) {
+	if err := r.db.Save(posPromotionRule).Error; err != nil {
+		return nil, err
+	}
+
+	// Update the promotion rule in Redis
+	promotionRuleData, err := json.Marshal(posPromotionRule)
+	if err != nil {
+		return nil, err
+	}
+	err = r.redis.Set(context.Background(), posPromotionRule.PromotionRuleID.String(), promotionRuleData, 7*24*time.Hour).Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return PosPromotionRuleToProto(posPromotionRule), nil
+}
+
+func (r *posPromotionRuleRepository) DeletePosPromotionRule(promotionRuleID string) error {
+	if err := r.db.Where("promotion_rule_id = ?", promotionRuleID).Delete(&entity.PosPromotionRule{}).Error; err != nil {
+		return err
+	}
+
+	// Delete the promotion rule from Redis
+	err := r.redis.Del(context.Background(), promotionRuleID).Err()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// posPromotionRuleListSpec pages the promotion rules in the order they were created
+var posPromotionRuleListSpec = listSpec{
+	dateColumn: "created_at",
+	keyColumn:  "promotion_rule_id",
+}
+
+func (r *posPromotionRuleRepository) ReadAllPosPromotionRules(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+	var posPromotionRules []entity.PosPromotionRule
+
+	query := r.db.Model(&entity.PosPromotionRule{})
+
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("company_id = ? AND (branch_id IS NULL OR branch_id = ?)", jwtPayload.CompanyId, jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("company_id = ? AND (store_id IS NULL OR store_id = ?)", jwtPayload.CompanyId, jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	return posPromotionRuleListSpec.readPage(query, pagination, nil, &posPromotionRules)
+}
+
+// ReadActivePosPromotionRules returns the active rules of a company whose validity window contains at
+func (r *posPromotionRuleRepository) ReadActivePosPromotionRules(companyID string, at time.Time) ([]entity.PosPromotionRule, error) {
+	var posPromotionRules []entity.PosPromotionRule
+	err := r.db.Where("company_id = ? AND active = ? AND start_date <= ? AND end_date >= ?", companyID, true, at, at).
+		Order("created_at").
+		Find(&posPromotionRules).Error
+	if err != nil {
+		return nil, err
+	}
+	return posPromotionRules, nil
+}
+
+// PosPromotionRuleToProto converts entity.PosPromotionRule to pb.PosPromotionRule
+func PosPromotionRuleToProto(posPromotionRule *entity.PosPromotionRule) *pb.PosPromotionRule {
+	pbPosPromotionRule := &pb.PosPromotionRule{
+		PromotionRuleId: posPromotionRule.PromotionRuleID.String(),
+		Name:            posPromotionRule.Name,
+		RuleType:        posPromotionRule.RuleType,
+		DiscountRate:    posPromotionRule.DiscountRate,
+		DiscountAmount:  money.ToProto(posPromotionRule.DiscountAmount),
+		BuyQuantity:     int32(posPromotionRule.BuyQuantity),
+		GetQuantity:     int32(posPromotionRule.GetQuantity),
+		BundlePrice:     money.ToProto(posPromotionRule.BundlePrice),
+		MinSubtotal:     money.ToProto(posPromotionRule.MinSubtotal),
+		StartDate:       timestamppb.New(posPromotionRule.StartDate),
+		EndDate:         timestamppb.New(posPromotionRule.EndDate),
+		Active:          posPromotionRule.Active,
+		CompanyId:       posPromotionRule.CompanyID.String(),
+		CreatedAt:       timestamppb.New(posPromotionRule.CreatedAt),
+		CreatedBy:       posPromotionRule.CreatedBy.String(),
+		UpdatedAt:       timestamppb.New(posPromotionRule.UpdatedAt),
+		UpdatedBy:       posPromotionRule.UpdatedBy.String(),
+	}
+
+	if posPromotionRule.ProductIDs != "" {
+		pbPosPromotionRule.ProductIds = strings.Split(posPromotionRule.ProductIDs, ",")
+	}
+
+	if posPromotionRule.StoreID != nil {
+		pbPosPromotionRule.StoreId = posPromotionRule.StoreID.String()
+	}
+
+	if posPromotionRule.BranchID != nil {
+		pbPosPromotionRule.BranchId = posPromotionRule.BranchID.String()
+	}
+
+	return pbPosPromotionRule
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosReceiptRepository interface {
+	ReadPosReceipt(posReceiptID string) (*pb.PosReceipt, error)
+	ReadPosReceiptByReceiptID(storeID string, receiptID string) (*entity.PosReceipt, error)
+	VoidPosReceipt(receiptVoid *dto.PosReceiptVoid) (*pb.PosReceipt, error)
+	ReadAllPosReceipts(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+}
+
+type posReceiptRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+	roles config.RoleSettings
+}
+
+func NewPosReceiptRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosReceiptRepository {
+	return &posReceiptRepository{
+		db:    db,
+		redis: redis,
+		roles: roles,
+	}
+}
+
+// posReceiptListSpec pages the receipts, newest first when they are numbered
+var posReceiptListSpec = listSpec{
+	dateColumn: "receipt_date",
+	keyColumn:  "pos_receipt_id",
+}
+
+func (r *posReceiptRepository) ReadAllPosReceipts(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+	var posReceipts []entity.PosReceipt
+
+	query := r.db.Model(&entity.PosReceipt{})
+
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	return posReceiptListSpec.readPage(query.Preload("Lines").Preload("Tenders"), pagination, &pb.ListQuery{Descending: true}, &posReceipts)
+}
+
+func (r *posReceiptRepository) ReadPosReceipt(posReceiptID string) (*pb.PosReceipt, error) {
+	// Try to get the receipt from Redis first
+	receiptData, err := r.redis.Get(context.Background(), posReceiptID).Result()
+	metrics.ObserveCacheLookup("receipt", err)
+	if err == redis.Nil {
+		// Receipt not found in Redis, get it with its lines and tenders from PostgreSQL
+		var posReceiptEntity entity.PosReceipt
+		if err := r.db.Preload("Lines").Preload("Tenders").Where("pos_receipt_id = ?", posReceiptID).First(&posReceiptEntity).Error; err != nil {
+			return nil, err
+		}
+
+		// Store the receipt in Redis for future queries
+		receiptData, err := json.Marshal(posReceiptEntity)
+		if err != nil {
+			return nil, err
+		}
+		err = r.redis.Set(context.Background(), posReceiptID, receiptData, 7*24*time.Hour).Err()
+		if err != nil {
+			return nil, err
+		}
+
+		return PosReceiptToProto(&posReceiptEntity), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Receipt found in Redis, unmarshal the data
+	var posReceiptEntity entity.PosReceipt
+	err = json.Unmarshal([]byte(receiptData), &posReceiptEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return PosReceiptToProto(&posReceiptEntity), nil
+}
+
+// ReadPosReceiptByReceiptID returns a receipt with its lines and tenders by the receipt number of its store
+func (r *posReceiptRepository) ReadPosReceiptByReceiptID(storeID string, receiptID string) (*entity.PosReceipt, error) {
+	var posReceiptEntity entity.PosReceipt
+	if err := r.db.Preload("Lines").Preload("Tenders").Where("store_id = ? AND receipt_id = ?", storeID, receiptID).First(&posReceiptEntity).Error; err != nil {
+		return nil, err
+	}
+	return &posReceiptEntity, nil
+}
+
+// VoidPosReceipt marks a completed receipt as voided and stores the records reversing its tenders.
+// The receipt row is locked, so a return booked at the same time either sees the void or is seen by its check.
+// The sale rows of the receipt stay, the sales lists and reports leave out the rows of voided receipts.
+func (r *posReceiptRepository) VoidPosReceipt(receiptVoid *dto.PosReceiptVoid) (*pb.PosReceipt, error) {
+	var posReceiptEntity entity.PosReceipt
+	posReceiptID := receiptVoid.PosReceiptID
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("pos_receipt_id = ?", posReceiptID).First(&posReceiptEntity).Error; err != nil {
+			return err
+		}
+
+		if posReceiptEntity.Status != entity.RECEIPT_STATUS_COMPLETED {
+			return errors.New("receipt is already voided")
+		}
+
+		// Returned items were already restocked and refunded, voiding would do it a second time
+		var returnCount int
+		if err := tx.Model(&entity.PosReturn{}).Where("pos_receipt_id = ?", posReceiptID).Count(&returnCount).Error; err != nil {
+			return err
+		}
+
+		if returnCount > 0 {
+			return errors.New("receipts with returns cannot be voided")
+		}
+
+		err := tx.Model(&entity.PosReceipt{}).
+			Where("pos_receipt_id = ?", posReceiptID).
+			Updates(map[string]interface{}{
+				"status":      entity.RECEIPT_STATUS_VOIDED,
+				"void_reason": receiptVoid.Reason,
+				"voided_at":   receiptVoid.VoidedAt,
+				"voided_by":   receiptVoid.VoidedBy,
+				"updated_at":  receiptVoid.VoidedAt,
+				"updated_by":  receiptVoid.VoidedBy,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		for _, cashDrawer := range receiptVoid.CashDrawers {
+			if err := lockOpenDrawerSession(tx, cashDrawer); err != nil {
+				return err
+			}
+			if err := tx.Create(cashDrawer).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, invoice := range receiptVoid.Invoices {
+			if err := tx.Create(invoice).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, onlinePayment := range receiptVoid.OnlinePayments {
+			if err := tx.Create(onlinePayment).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Preload("Lines").Preload("Tenders").Where("pos_receipt_id = ?", posReceiptID).First(&posReceiptEntity).Error; err != nil {
+			return err
+		}
+
+		// The sale is taken out of the hour it was made in, the dashboards no longer count it
+		return upsertPosSalesRollups(tx, receiptRollups(&posReceiptEntity, -1), receiptVoid.VoidedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the receipt in Redis
+	receiptData, err := json.Marshal(posReceiptEntity)
+	if err != nil {
+		return nil, err
+	}
+	err = r.redis.Set(context.Background(), posReceiptID, receiptData, 7*24*time.Hour).Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return PosReceiptToProto(&posReceiptEntity), nil
+}
+
+// PosReceiptToProto converts a receipt with its lines and tenders to pb.PosReceipt, amounts are in the currency of the receipt
+func PosReceiptToProto(posReceipt *entity.PosReceipt) *pb.PosReceipt {
+	pbPosReceipt := &pb.PosReceipt{
+		PosReceiptId:       posReceipt.PosReceiptID.String(),
+		ReceiptId:          posReceipt.ReceiptID,
+		StoreId:            posReceipt.StoreID.String(),
+		BranchId:           posReceipt.BranchID.String(),
+		CompanyId:          posReceipt.CompanyID.String(),
+		CustomerId:         posReceipt.CustomerID.String(),
+		CashierId:          posReceipt.CashierID.String(),
+		Status:             posReceipt.Status,
+		SubTotal:           money.ToProtoIn(posReceipt.SubTotal, posReceipt.CurrencyCode),
+		DiscountTotal:      money.ToProtoIn(posReceipt.DiscountTotal, posReceipt.CurrencyCode),
+		TaxTotal:           money.ToProtoIn(posReceipt.TaxTotal, posReceipt.CurrencyCode),
+		Total:              money.ToProtoIn(posReceipt.Total, posReceipt.CurrencyCode),
+		CashTendered:       money.ToProtoIn(posReceipt.CashTendered, posReceipt.CurrencyCode),
+		ChangeAmount:       money.ToProtoIn(posReceipt.ChangeAmount, posReceipt.CurrencyCode),
+		ReceiptPromotionId: posReceipt.ReceiptPromotionID,
+		ReceiptDate:        timestamppb.New(posReceipt.ReceiptDate),
+		VoidReason:         posReceipt.VoidReason,
+		CreatedAt:          timestamppb.New(posReceipt.CreatedAt),
+		CreatedBy:          posReceipt.CreatedBy.String(),
+		UpdatedAt:          timestamppb.New(posReceipt.UpdatedAt),
+		UpdatedBy:          posReceipt.UpdatedBy.String(),
+	}
+
+	if posReceipt.VoidedAt != nil {
+		pbPosReceipt.VoidedAt = timestamppb.New(*posReceipt.VoidedAt)
+	}
+
+	if posReceipt.VoidedBy != nil {
+		pbPosReceipt.VoidedBy = posReceipt.VoidedBy.String()
+	}
+
+	for _, line := range posReceipt.Lines {
+		pbPosReceipt.Lines = append(pbPosReceipt.Lines, &pb.PosReceiptLine{
+			LineId:         line.LineID.String(),
+			PosReceiptId:   line.PosReceiptID.String(),
+			SaleId:         line.SaleID.String(),
+			ProductId:      line.ProductID.String(),
+			ProductName:    line.ProductName,
+			Quantity:       int32(line.Quantity),
+			UnitPrice:      money.ToProtoIn(line.UnitPrice, posReceipt.CurrencyCode),
+			DiscountAmount: money.ToProtoIn(line.DiscountAmount, posReceipt.CurrencyCode),
+			TotalPrice:     money.ToProtoIn(line.TotalPrice, posReceipt.CurrencyCode),
+			TaxRate:        line.TaxRate,
+			TaxAmount:      money.ToProtoIn(line.TaxAmount, posReceipt.CurrencyCode),
+			PromotionId:    line.PromotionID,
+			TaxInclusive:   line.TaxInclusive,
+		})
+	}
+
+	for _, tender := range posReceipt.Tenders {
+		pbPosReceipt.Tenders = append(pbPosReceipt.Tenders, &pb.PosReceiptTender{
+			TenderId:          tender.TenderID.String(),
+			PosReceiptId:      tender.PosReceiptID.String(),
+			PaymentMethodId:   tender.PaymentMethodID.String(),
+			PaymentMethodName: tender.PaymentMethodName,
+			Amount:            money.ToProtoIn(tender.Amount, posReceipt.CurrencyCode),
+			CashTendered:      money.ToProtoIn(tender.CashTendered, posReceipt.CurrencyCode),
+			ChangeAmount:      money.ToProtoIn(tender.ChangeAmount, posReceipt.CurrencyCode),
+		})
+	}
+
+	return pbPosReceipt
+}
@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+
+	"github.com/jinzhu/gorm"
+)
+
+// PosReportRepository sums the sales records over a period, from inclusive and to exclusive.
+// The timestamp columns hold UTC, the bounds are converted before they are compared.
+type PosReportRepository interface {
+	ReadPosCashierSalesTotals(storeID string, from time.Time, to time.Time) ([]dto.PosCashierSalesTotals, error)
+	ReadPosCashierReturnTotals(storeID string, from time.Time, to time.Time) ([]dto.PosCashierReturnTotals, error)
+	ReadPosTenderTotals(storeID string, branchID string, companyID string, from time.Time, to time.Time) ([]dto.PosTenderTotals, error)
+	ReadPosSalesSeries(query dto.PosSalesSeriesQuery, roleName string, jwtPayload *pb.JWTPayload) ([]dto.PosSalesSeriesRow, error)
+	RebuildPosSalesRollups(from time.Time, to time.Time) (int, error)
+}
+
+type posReportRepository struct {
+	db       *gorm.DB
+	payments config.PaymentSettings
+	roles    config.RoleSettings
+}
+
+func NewPosReportRepository(db *gorm.DB, payments config.PaymentSettings, roles config.RoleSettings) PosReportRepository {
+	return &posReportRepository{
+		db:       db,
+		payments: payments,
+		roles:    roles,
+	}
+}
+
+// voidedReceipts selects the receipt numbers of the store that were voided, their rows are left out of every total
+func (r *posReportRepository) voidedReceipts(storeID string) interface{} {
+	return r.db.Model(&entity.PosReceipt{}).
+		Select("receipt_id").
+		Where("store_id = ? AND status = ?", storeID, entity.RECEIPT_STATUS_VOIDED).
+		SubQuery()
+}
+
+// storeReceipts selects the receipt numbers the store sold under, it ties the tenders to the store
+func (r *posReportRepository) storeReceipts(storeID string) interface{} {
+	return r.db.Model(&entity.PosSale{}).
+		Select("DISTINCT receipt_id").
+		Where("store_id = ?", storeID).
+		SubQuery()
+}
+
+// ReadPosCashierSalesTotals sums the sale lines of every cashier, a transaction is a receipt
+func (r *posReportRepository) ReadPosCashierSalesTotals(storeID string, from time.Time, to time.Time) ([]dto.PosCashierSalesTotals, error) {
+	var totals []dto.PosCashierSalesTotals
+	err := r.db.Model(&entity.PosSale{}).
+		Select("cashier_id, "+
+			"COALESCE(SUM(price * quantity), 0) AS gross_sales, "+
+			"COALESCE(SUM(discount_amount), 0) AS discounts, "+
+			"COALESCE(SUM(tax_amount), 0) AS taxes, "+
+			"COUNT(DISTINCT receipt_id) AS transactions").
+		Where("store_id = ? AND sale_date >= ? AND sale_date < ?", storeID, from.UTC(), to.UTC()).
+		Where("receipt_id NOT IN ?", r.voidedReceipts(storeID)).
+		Group("cashier_id").
+		Order("cashier_id").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+// ReadPosCashierReturnTotals sums the refunds of every cashier, the cashier is who booked the return
+func (r *posReportRepository) ReadPosCashierReturnTotals(storeID string, from time.Time, to time.Time) ([]dto.PosCashierReturnTotals, error) {
+	var totals []dto.PosCashierReturnTotals
+	err := r.db.Model(&entity.PosReturn{}).
+		Select("created_by AS cashier_id, COALESCE(SUM(amount), 0) AS returns, COALESCE(SUM(tax_amount), 0) AS return_taxes, COUNT(*) AS count").
+		Where("store_id = ? AND return_date >= ? AND return_date < ?", storeID, from.UTC(), to.UTC()).
+		Group("created_by").
+		Order("created_by").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+// ReadPosTenderTotals sums the cash drawer, invoice and online payment rows of the store's receipts.
+// Refunds are the cash paid out and the negative invoices and payments booked by the returns.
+// Drawer rows of other receipts, such as floats and pay-ins, are not tenders and are left out.
+func (r *posReportRepository) ReadPosTenderTotals(storeID string, branchID string, companyID string, from time.Time, to time.Time) ([]dto.PosTenderTotals, error) {
+	methodIDs, err := r.paymentMethodIDs(companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cash dto.PosTenderTotals
+	err = r.db.Model(&entity.PosCashDrawer{}).
+		Select("COALESCE(SUM(CASE WHEN cash_in > 0 THEN amount ELSE 0 END), 0) AS sales, "+
+			"COALESCE(SUM(CASE WHEN cash_in = 0 THEN cash_out ELSE 0 END), 0) AS refunds, "+
+			"COUNT(DISTINCT CASE WHEN cash_in > 0 THEN receipt_id END) AS transactions").
+		Where("store_id = ? AND transaction_time >= ? AND transaction_time < ?", storeID, from.UTC(), to.UTC()).
+		Where("receipt_id IN ?", r.storeReceipts(storeID)).
+		Where("receipt_id NOT IN ?", r.voidedReceipts(storeID)).
+		Scan(&cash).Error
+	if err != nil {
+		return nil, err
+	}
+	cash.PaymentMethodID = methodIDs[r.payments.CashMethod]
+	cash.MethodName = r.payments.CashMethod
+
+	// Invoices carry no store, the receipt numbers of the store and its branch pick them out
+	var payLater dto.PosTenderTotals
+	err = r.db.Model(&entity.PosInvoice{}).
+		Select("COALESCE(SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END), 0) AS sales, "+
+			"COALESCE(SUM(CASE WHEN amount < 0 THEN -amount ELSE 0 END), 0) AS refunds, "+
+			"COUNT(DISTINCT CASE WHEN amount > 0 THEN receipt_id END) AS transactions").
+		Where(`branch_id = ? AND "date" >= ? AND "date" < ?`, branchID, from.UTC(), to.UTC()).
+		Where("receipt_id IN ?", r.storeReceipts(storeID)).
+		Where("receipt_id NOT IN ?", r.voidedReceipts(storeID)).
+		Scan(&payLater).Error
+	if err != nil {
+		return nil, err
+	}
+	payLater.PaymentMethodID = methodIDs[r.payments.PayLaterMethod]
+	payLater.MethodName = r.payments.PayLaterMethod
+
+	var online []dto.PosTenderTotals
+	err = r.db.Table("pos_online_payments AS p").
+		Select("p.payment_method AS payment_method_id, "+
+			"COALESCE(MAX(m.method_name), '') AS method_name, "+
+			"COALESCE(SUM(CASE WHEN p.amount > 0 THEN p.amount ELSE 0 END), 0) AS sales, "+
+			"COALESCE(SUM(CASE WHEN p.amount < 0 THEN -p.amount ELSE 0 END), 0) AS refunds, "+
+			"COUNT(DISTINCT CASE WHEN p.amount > 0 THEN p.receipt_id END) AS transactions").
+		Joins("LEFT JOIN pos_payment_methods AS m ON m.payment_method_id = p.payment_method").
+		Where("p.store_id = ? AND p.payment_date >= ? AND p.payment_date < ?", storeID, from.UTC(), to.UTC()).
+		Where("p.receipt_id NOT IN ?", r.voidedReceipts(storeID)).
+		Group("p.payment_method").
+		Order("method_name").
+		Scan(&online).Error
+	if err != nil {
+		return nil, err
+	}
+
+	totals := []dto.PosTenderTotals{}
+	for _, tender := range append([]dto.PosTenderTotals{cash, payLater}, online...) {
+		if tender.Sales == 0 && tender.Refunds == 0 {
+			continue
+		}
+		totals = append(totals, tender)
+	}
+	return totals, nil
+}
+
+// paymentMethodIDs maps the cash and pay later method names of the settings to their ids in the company
+func (r *posReportRepository) paymentMethodIDs(companyID string) (map[string]string, error) {
+	var methods []entity.PosPaymentMethod
+	if err := r.db.Where("company_id = ? AND method_name IN (?)", companyID, []string{r.payments.CashMethod, r.payments.PayLaterMethod}).
+		Find(&methods).Error; err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(methods))
+	for _, method := range methods {
+		ids[method.MethodName] = method.PaymentMethodID.String()
+	}
+	return ids, nil
+}
+
+// seriesKeyColumns are the rollup columns a time series can be broken down by
+var seriesKeyColumns = map[string]string{
+	dto.SERIES_GROUP_BY_STORE:          "r.store_id",
+	dto.SERIES_GROUP_BY_BRANCH:         "r.branch_id",
+	dto.SERIES_GROUP_BY_PAYMENT_METHOD: "r.payment_method_id",
+}
+
+// ReadPosSalesSeries sums the hourly rollups into the buckets of the query, in its location.
+// Company users read their company and branch users their branch, like the record lists.
+func (r *posReportRepository) ReadPosSalesSeries(query dto.PosSalesSeriesQuery, roleName string, jwtPayload *pb.JWTPayload) ([]dto.PosSalesSeriesRow, error) {
+	// The rollups hold UTC hours, they are moved to the wall clock of the location before they are bucketed.
+	// An hour bucket is one rollup hour, in a location with a half hour offset it starts at half past.
+	bucket := "date_trunc(?, (r.bucket_start AT TIME ZONE 'UTC') AT TIME ZONE ?)"
+	bucketArgs := []interface{}{query.Interval, query.Location.String()}
+	if query.Interval == dto.SERIES_INTERVAL_HOUR {
+		bucket = "(r.bucket_start AT TIME ZONE 'UTC') AT TIME ZONE ?"
+		bucketArgs = bucketArgs[1:]
+	}
+
+	selects := bucket + " AS bucket, " +
+		"COALESCE(SUM(r.gross_sales), 0) AS gross_sales, " +
+		"COALESCE(SUM(r.discounts), 0) AS discounts, " +
+		"COALESCE(SUM(r.taxes), 0) AS taxes, " +
+		"COALESCE(SUM(r.returns), 0) AS returns, " +
+		"COALESCE(SUM(r.return_taxes), 0) AS return_taxes, " +
+		"COALESCE(SUM(r.transactions), 0) AS transactions"
+	groups := "bucket"
+
+	db := r.db.Table("pos_sales_rollups AS r")
+
+	if query.GroupBy != "" {
+		keyColumn, ok := seriesKeyColumns[query.GroupBy]
+		if !ok {
+			return nil, errors.New("invalid group by")
+		}
+		selects += ", CAST(" + keyColumn + " AS text) AS series_key"
+		groups = keyColumn + ", " + groups
+
+		if query.GroupBy == dto.SERIES_GROUP_BY_PAYMENT_METHOD {
+			selects += ", COALESCE(MAX(m.method_name), '') AS label"
+			db = db.Joins("LEFT JOIN pos_payment_methods AS m ON m.payment_method_id = r.payment_method_id")
+		}
+	}
+
+	db = db.Select(selects, bucketArgs...)
+
+	switch roleName {
+	case r.roles.CompanyUserRole:
+		db = db.Where("r.company_id = ?", jwtPayload.CompanyId)
+	case r.roles.BranchUserRole:
+		db = db.Where("r.branch_id = ?", jwtPayload.BranchId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if query.BranchID != "" {
+		db = db.Where("r.branch_id = ?", query.BranchID)
+	}
+	if query.StoreID != "" {
+		db = db.Where("r.store_id = ?", query.StoreID)
+	}
+	if query.PaymentMethodID != "" {
+		db = db.Where("r.payment_method_id = ?", query.PaymentMethodID)
+	}
+
+	var rows []dto.PosSalesSeriesRow
+	err := db.Where("r.bucket_start >= ? AND r.bucket_start < ?", query.From.UTC(), query.To.UTC()).
+		Group(groups).
+		Order(groups).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// The bucket is the wall clock of the location without a zone, its minutes carry the part of the offset below an hour
+	for i := range rows {
+		bucket := rows[i].Bucket
+		rows[i].Bucket = time.Date(bucket.Year(), bucket.Month(), bucket.Day(), bucket.Hour(), bucket.Minute(), 0, 0, query.Location)
+	}
+
+	return rows, nil
+}
+
+// RebuildPosSalesRollups computes the rollups of the UTC hours between from and to again from the receipts and returns.
+// It fills the rollups of the sales made before they existed and repairs a period after the records were changed by hand.
+// The table is locked for the rebuild, checkouts wait for it and add their sales to the rebuilt rows.
+func (r *posReportRepository) RebuildPosSalesRollups(from time.Time, to time.Time) (int, error) {
+	from = from.UTC().Truncate(time.Hour)
+	if truncated := to.UTC().Truncate(time.Hour); truncated.Before(to) {
+		to = truncated.Add(time.Hour)
+	}
+
+	var written int
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("LOCK TABLE pos_sales_rollups IN SHARE ROW EXCLUSIVE MODE").Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("bucket_start >= ? AND bucket_start < ?", from, to).Delete(&entity.PosSalesRollup{}).Error; err != nil {
+			return err
+		}
+
+		rollups := []entity.PosSalesRollup{}
+
+		// Voided receipts are left out, their sale and its void would cancel out
+		var last *entity.PosReceipt
+		for {
+			batch := tx.Preload("Lines").Preload("Tenders").
+				Where("status = ? AND receipt_date >= ? AND receipt_date < ?", entity.RECEIPT_STATUS_COMPLETED, from, to)
+			if last != nil {
+				batch = batch.Where("(receipt_date, pos_receipt_id) > (?, ?)", last.ReceiptDate, last.PosReceiptID)
+			}
+
+			var posReceipts []entity.PosReceipt
+			if err := batch.Order("receipt_date, pos_receipt_id").Limit(ROLLUP_REBUILD_BATCH_SIZE).Find(&posReceipts).Error; err != nil {
+				return err
+			}
+
+			for i := range posReceipts {
+				rollups = append(rollups, receiptRollups(&posReceipts[i], 1)...)
+			}
+
+			if len(posReceipts) < ROLLUP_REBUILD_BATCH_SIZE {
+				break
+			}
+			last = &posReceipts[len(posReceipts)-1]
+		}
+
+		var returns []entity.PosSalesRollup
+		if err := tx.Model(&entity.PosReturn{}).
+			Select("company_id, branch_id, date_trunc('hour', return_date) AS bucket_start, store_id, payment_method_id, SUM(amount) AS returns, SUM(tax_amount) AS return_taxes").
+			Where("return_date >= ? AND return_date < ?", from, to).
+			Group("company_id, branch_id, date_trunc('hour', return_date), store_id, payment_method_id").
+			Scan(&returns).Error; err != nil {
+			return err
+		}
+		rollups = append(rollups, returns...)
+
+		rollups = mergePosSalesRollups(rollups)
+		written = len(rollups)
+		return upsertPosSalesRollups(tx, rollups, time.Now())
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return written, nil
+}
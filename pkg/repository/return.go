@@ -4,13 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
-	"os"
+	"fmt"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jinzhu/gorm"
@@ -19,21 +21,25 @@ import (
 
 type PosReturnRepository interface {
 	CreatePosReturn(posReturn *entity.PosReturn) error
+	CreatePosReturnRefund(refund *dto.PosReturnRefund) error
+	ReadPosReturnedQuantity(saleID string) (int, error)
 	ReadPosReturn(returnID string) (*pb.PosReturn, error)
 	UpdatePosReturn(posReturn *entity.PosReturn) (*pb.PosReturn, error)
 	DeletePosReturn(returnID string) error
-	ReadAllPosReturns(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadAllPosReturns(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error)
 }
 
 type posReturnRepository struct {
 	db    *gorm.DB
 	redis *redis.Client
+	roles config.RoleSettings
 }
 
-func NewPosReturnRepository(db *gorm.DB, redis *redis.Client) PosReturnRepository {
+func NewPosReturnRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosReturnRepository {
 	return &posReturnRepository{
 		db:    db,
 		redis: redis,
+		roles: roles,
 	}
 }
 
@@ -45,15 +51,141 @@ func (r *posReturnRepository) CreatePosReturn(posReturn *entity.PosReturn) error
 	return nil
 }
 
-func (r *posReturnRepository) ReadAllPosReturns(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+// CreatePosReturnRefund stores a return with its refund in a single transaction.
+// The receipt is locked first, like a void does, so a receipt cannot be voided and returned at the same time.
+// The sale line and the tender are locked while the earlier returns and refunds are summed, so concurrent returns
+// cannot take back more than was sold or refund more than the tender paid.
+func (r *posReturnRepository) CreatePosReturnRefund(refund *dto.PosReturnRefund) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		posReturn := refund.Return
+
+		var posReceipt entity.PosReceipt
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("pos_receipt_id = ?", posReturn.PosReceiptID).First(&posReceipt).Error; err != nil {
+			return err
+		}
+
+		if posReceipt.Status != entity.RECEIPT_STATUS_COMPLETED {
+			return errors.New("only completed receipts can be returned")
+		}
+
+		var line entity.PosReceiptLine
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("pos_receipt_id = ? AND sale_id = ?", posReturn.PosReceiptID, posReturn.SaleID).
+			First(&line).Error; err != nil {
+			return err
+		}
+
+		returnedQuantity, err := sumReturnedQuantity(tx, posReturn.SaleID.String())
+		if err != nil {
+			return err
+		}
+
+		if returnedQuantity+posReturn.Quantity > line.Quantity {
+			return fmt.Errorf("only %d of the %d sold items can still be returned", line.Quantity-returnedQuantity, line.Quantity)
+		}
+
+		if posReturn.TenderID == nil {
+			return errors.New("return has no refund tender")
+		}
+
+		var tender entity.PosReceiptTender
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("pos_receipt_id = ? AND tender_id = ?", posReturn.PosReceiptID, *posReturn.TenderID).
+			First(&tender).Error; err != nil {
+			return err
+		}
+
+		// Returns booked before the tender was recorded count against the tenders of their payment method
+		var refunded struct{ Total money.Amount }
+		if err := tx.Model(&entity.PosReturn{}).
+			Select("COALESCE(SUM(amount), 0) AS total").
+			Where("pos_receipt_id = ? AND (tender_id = ? OR (tender_id IS NULL AND payment_method_id = ?))", posReturn.PosReceiptID, tender.TenderID, tender.PaymentMethodID).
+			Scan(&refunded).Error; err != nil {
+			return err
+		}
+
+		if refunded.Total+posReturn.Amount > tender.Amount {
+			return fmt.Errorf("refund %s exceeds the %s left on the %s tender", posReturn.Amount, tender.Amount-refunded.Total, tender.PaymentMethodName)
+		}
+
+		if err := tx.Create(posReturn).Error; err != nil {
+			return err
+		}
+
+		if refund.CashDrawer != nil {
+			if err := lockOpenDrawerSession(tx, refund.CashDrawer); err != nil {
+				return err
+			}
+			if err := tx.Create(refund.CashDrawer).Error; err != nil {
+				return err
+			}
+		}
+
+		if refund.Invoice != nil {
+			if err := tx.Create(refund.Invoice).Error; err != nil {
+				return err
+			}
+		}
+
+		if refund.OnlinePayment != nil {
+			if err := tx.Create(refund.OnlinePayment).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := upsertPosSalesRollups(tx, []entity.PosSalesRollup{returnRollup(posReturn)}, time.Now()); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ReadPosReturnedQuantity returns how many items of a sale line were returned so far
+func (r *posReturnRepository) ReadPosReturnedQuantity(saleID string) (int, error) {
+	return sumReturnedQuantity(r.db, saleID)
+}
+
+func sumReturnedQuantity(db *gorm.DB, saleID string) (int, error) {
+	var returned struct{ Quantity int }
+	if err := db.Model(&entity.PosReturn{}).
+		Select("COALESCE(SUM(quantity), 0) AS quantity").
+		Where("sale_id = ?", saleID).
+		Scan(&returned).Error; err != nil {
+		return 0, err
+	}
+	return returned.Quantity, nil
+}
+
+// posReturnListSpec lists the return fields the back office searches and orders by
+var posReturnListSpec = listSpec{
+	filters: map[string]listColumn{
+		"receipt_id":        textColumn("receipt_id"),
+		"pos_receipt_id":    uuidColumn("pos_receipt_id"),
+		"sale_id":           uuidColumn("sale_id"),
+		"product_id":        uuidColumn("product_id"),
+		"payment_method_id": uuidColumn("payment_method_id"),
+		"store_id":          uuidColumn("store_id"),
+		"branch_id":         uuidColumn("branch_id"),
+	},
+	sorts: map[string]string{
+		"return_date": "return_date",
+		"amount":      "amount",
+		"quantity":    "quantity",
+		"created_at":  "created_at",
+	},
+	dateColumn: "return_date",
+	keyColumn:  "return_id",
+}
+
+func (r *posReturnRepository) ReadAllPosReturns(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error) {
 	var posReturns []entity.PosReturn
-	var totalRecords int64
 
 	query := r.db.Model(&entity.PosReturn{})
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
 
 	switch roleName {
 	case companyRole:
@@ -66,27 +198,13 @@ func (r *posReturnRepository) ReadAllPosReturns(pagination dto.Pagination, roleN
 		return nil, errors.New("invalid role")
 	}
 
-	if pagination.Limit > 0 && pagination.Page > 0 {
-		offset := (pagination.Page - 1) * pagination.Limit
-		query = query.Offset(offset).Limit(pagination.Limit)
-	}
-
-	query.Find(&posReturns)
-	query.Count(&totalRecords)
-
-	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
-
-	return &dto.PaginationResult{
-		TotalRecords: totalRecords,
-		Records:      posReturns,
-		CurrentPage:  pagination.Page,
-		TotalPages:   totalPages,
-	}, nil
+	return posReturnListSpec.readPage(query, pagination, listQuery, &posReturns)
 }
 
 func (r *posReturnRepository) ReadPosReturn(returnID string) (*pb.PosReturn, error) {
 	// Try to get the return from Redis first
 	returnData, err := r.redis.Get(context.Background(), returnID).Result()
+	metrics.ObserveCacheLookup("return", err)
 	if err == redis.Nil {
 		// Return not found in Redis, get from PostgreSQL
 		var posReturnEntity entity.PosReturn
@@ -96,21 +214,28 @@ func (r *posReturnRepository) ReadPosReturn(returnID string) (*pb.PosReturn, err
 
 		// Convert entity.PosReturn to pb.PosReturn
 		posReturn := &pb.PosReturn{
-			ReturnId:   posReturnEntity.ReturnID.String(),
-			ReceiptId:  posReturnEntity.ReceiptID,
-			ProductId:  posReturnEntity.ProductID.String(),
-			Quantity:   int32(posReturnEntity.Quantity),
-			Price:      float64(posReturnEntity.Price),
-			Amount:     float64(posReturnEntity.Amount),
-			ReturnDate: timestamppb.New(posReturnEntity.ReturnDate),
-			Reason:     posReturnEntity.Reason,
-			StoreId:    posReturnEntity.StoreID.String(),
-			BranchId:   posReturnEntity.BranchID.String(),
-			CompanyId:  posReturnEntity.CompanyID.String(),
-			CreatedAt:  timestamppb.New(posReturnEntity.CreatedAt),
-			CreatedBy:  posReturnEntity.CreatedBy.String(),
-			UpdatedAt:  timestamppb.New(posReturnEntity.UpdatedAt),
-			UpdatedBy:  posReturnEntity.UpdatedBy.String(),
+			ReturnId:        posReturnEntity.ReturnID.String(),
+			ReceiptId:       posReturnEntity.ReceiptID,
+			PosReceiptId:    posReturnEntity.PosReceiptID.String(),
+			SaleId:          posReturnEntity.SaleID.String(),
+			PaymentMethodId: posReturnEntity.PaymentMethodID.String(),
+			ProductId:       posReturnEntity.ProductID.String(),
+			Quantity:        int32(posReturnEntity.Quantity),
+			Price:           money.ToProto(posReturnEntity.Price),
+			Amount:          money.ToProto(posReturnEntity.Amount),
+			TaxAmount:       money.ToProto(posReturnEntity.TaxAmount),
+			ReturnDate:      timestamppb.New(posReturnEntity.ReturnDate),
+			Reason:          posReturnEntity.Reason,
+			StoreId:         posReturnEntity.StoreID.String(),
+			BranchId:        posReturnEntity.BranchID.String(),
+			CompanyId:       posReturnEntity.CompanyID.String(),
+			CreatedAt:       timestamppb.New(posReturnEntity.CreatedAt),
+			CreatedBy:       posReturnEntity.CreatedBy.String(),
+			UpdatedAt:       timestamppb.New(posReturnEntity.UpdatedAt),
+			UpdatedBy:       posReturnEntity.UpdatedBy.String(),
+		}
+		if posReturnEntity.TenderID != nil {
+			posReturn.TenderId = posReturnEntity.TenderID.String()
 		}
 
 		// Store the return in Redis for future queries
@@ -137,21 +262,28 @@ func (r *posReturnRepository) ReadPosReturn(returnID string) (*pb.PosReturn, err
 
 	// Convert entity.PosReturn to pb.PosReturn
 	posReturn := &pb.PosReturn{
-		ReturnId:   posReturnEntity.ReturnID.String(),
-		ReceiptId:  posReturnEntity.ReceiptID,
-		ProductId:  posReturnEntity.ProductID.String(),
-		Quantity:   int32(posReturnEntity.Quantity),
-		Price:      float64(posReturnEntity.Price),
-		Amount:     float64(posReturnEntity.Amount),
-		ReturnDate: timestamppb.New(posReturnEntity.ReturnDate),
-		Reason:     posReturnEntity.Reason,
-		StoreId:    posReturnEntity.StoreID.String(),
-		BranchId:   posReturnEntity.BranchID.String(),
-		CompanyId:  posReturnEntity.CompanyID.String(),
-		CreatedAt:  timestamppb.New(posReturnEntity.CreatedAt),
-		CreatedBy:  posReturnEntity.CreatedBy.String(),
-		UpdatedAt:  timestamppb.New(posReturnEntity.UpdatedAt),
-		UpdatedBy:  posReturnEntity.UpdatedBy.String(),
+		ReturnId:        posReturnEntity.ReturnID.String(),
+		ReceiptId:       posReturnEntity.ReceiptID,
+		PosReceiptId:    posReturnEntity.PosReceiptID.String(),
+		SaleId:          posReturnEntity.SaleID.String(),
+		PaymentMethodId: posReturnEntity.PaymentMethodID.String(),
+		ProductId:       posReturnEntity.ProductID.String(),
+		Quantity:        int32(posReturnEntity.Quantity),
+		Price:           money.ToProto(posReturnEntity.Price),
+		Amount:          money.ToProto(posReturnEntity.Amount),
+		TaxAmount:       money.ToProto(posReturnEntity.TaxAmount),
+		ReturnDate:      timestamppb.New(posReturnEntity.ReturnDate),
+		Reason:          posReturnEntity.Reason,
+		StoreId:         posReturnEntity.StoreID.String(),
+		BranchId:        posReturnEntity.BranchID.String(),
+		CompanyId:       posReturnEntity.CompanyID.String(),
+		CreatedAt:       timestamppb.New(posReturnEntity.CreatedAt),
+		CreatedBy:       posReturnEntity.CreatedBy.String(),
+		UpdatedAt:       timestamppb.New(posReturnEntity.UpdatedAt),
+		UpdatedBy:       posReturnEntity.UpdatedBy.String(),
+	}
+	if posReturnEntity.TenderID != nil {
+		posReturn.TenderId = posReturnEntity.TenderID.String()
 	}
 
 	return posReturn, nil
@@ -164,21 +296,28 @@ func (r *posReturnRepository) UpdatePosReturn(posReturn *entity.PosReturn) (*pb.
 
 	// Convert updated entity.PosReturn back to pb.PosReturn
 	updatedPosReturn := &pb.PosReturn{
-		ReturnId:   posReturn.ReturnID.String(),
-		ReceiptId:  posReturn.ReceiptID,
-		ProductId:  posReturn.ProductID.String(),
-		Quantity:   int32(posReturn.Quantity),
-		Price:      float64(posReturn.Price),
-		Amount:     float64(posReturn.Amount),
-		ReturnDate: timestamppb.New(posReturn.ReturnDate),
-		Reason:     posReturn.Reason,
-		StoreId:    posReturn.StoreID.String(),
-		BranchId:   posReturn.BranchID.String(),
-		CompanyId:  posReturn.CompanyID.String(),
-		CreatedAt:  timestamppb.New(posReturn.CreatedAt),
-		CreatedBy:  posReturn.CreatedBy.String(),
-		UpdatedAt:  timestamppb.New(posReturn.UpdatedAt),
-		UpdatedBy:  posReturn.UpdatedBy.String(),
+		ReturnId:        posReturn.ReturnID.String(),
+		ReceiptId:       posReturn.ReceiptID,
+		PosReceiptId:    posReturn.PosReceiptID.String(),
+		SaleId:          posReturn.SaleID.String(),
+		PaymentMethodId: posReturn.PaymentMethodID.String(),
+		ProductId:       posReturn.ProductID.String(),
+		Quantity:        int32(posReturn.Quantity),
+		Price:           money.ToProto(posReturn.Price),
+		Amount:          money.ToProto(posReturn.Amount),
+		TaxAmount:       money.ToProto(posReturn.TaxAmount),
+		ReturnDate:      timestamppb.New(posReturn.ReturnDate),
+		Reason:          posReturn.Reason,
+		StoreId:         posReturn.StoreID.String(),
+		BranchId:        posReturn.BranchID.String(),
+		CompanyId:       posReturn.CompanyID.String(),
+		CreatedAt:       timestamppb.New(posReturn.CreatedAt),
+		CreatedBy:       posReturn.CreatedBy.String(),
+		UpdatedAt:       timestamppb.New(posReturn.UpdatedAt),
+		UpdatedBy:       posReturn.UpdatedBy.String(),
+	}
+	if posReturn.TenderID != nil {
+		updatedPosReturn.TenderId = posReturn.TenderID.String()
 	}
 
 	// Update the return in Redis
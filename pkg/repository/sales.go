@@ -4,15 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
-	"os"
-	"strconv"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
-	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jinzhu/gorm"
@@ -20,60 +19,56 @@ import (
 )
 
 type PosSaleRepository interface {
-	CreatePosSales(posSale []*entity.PosSale, token string) ([]*entity.PosSale, error)
 	ReadPosSale(saleID string) (*pb.PosSale, error)
 	UpdatePosSale(posSale *entity.PosSale) (*pb.PosSale, error)
 	DeletePosSale(saleID string) error
-	ReadAllPosSales(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadAllPosSales(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error)
 }
 
 type posSaleRepository struct {
 	db    *gorm.DB
 	redis *redis.Client
+	roles config.RoleSettings
 }
 
-func NewPosSaleRepository(db *gorm.DB, redis *redis.Client) PosSaleRepository {
+func NewPosSaleRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosSaleRepository {
 	return &posSaleRepository{
 		db:    db,
 		redis: redis,
+		roles: roles,
 	}
 }
 
-func (r *posSaleRepository) CreatePosSales(posSales []*entity.PosSale, token string) ([]*entity.PosSale, error) {
-	var createdPosSales []*entity.PosSale
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		// Get a new ReceiptID from the Store service
-		storeID := posSales[0].StoreID.String()
-		receiptID, err := utils.GetNextReceiptID(storeID, token)
-		if err != nil {
-			return err
-		}
-		for _, posSale := range posSales {
-
-			posSale.ReceiptID = strconv.Itoa(receiptID.Data.ReceiptID)
-			result := tx.Create(posSale)
-			if result.Error != nil {
-				return result.Error
-			}
-			createdPosSales = append(createdPosSales, posSale)
-		}
-		return nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	return createdPosSales, nil
+// posSaleListSpec lists the sale fields the back office searches and orders by
+var posSaleListSpec = listSpec{
+	filters: map[string]listColumn{
+		"receipt_id":        textColumn("receipt_id"),
+		"cashier_id":        uuidColumn("cashier_id"),
+		"customer_id":       uuidColumn("customer_id"),
+		"product_id":        uuidColumn("product_id"),
+		"payment_method_id": uuidColumn("payment_method_id"),
+		"promotion_id":      textColumn("promotion_id"),
+		"store_id":          uuidColumn("store_id"),
+		"branch_id":         uuidColumn("branch_id"),
+	},
+	sorts: map[string]string{
+		"sale_date":   "sale_date",
+		"total_price": "total_price",
+		"quantity":    "quantity",
+		"created_at":  "created_at",
+	},
+	dateColumn: "sale_date",
+	keyColumn:  "sale_id",
 }
 
-func (r *posSaleRepository) ReadAllPosSales(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+func (r *posSaleRepository) ReadAllPosSales(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, listQuery *pb.ListQuery) (*dto.PaginationResult, error) {
 	var posSales []entity.PosSale
-	var totalRecords int64
 
 	query := r.db.Model(&entity.PosSale{})
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
 
 	switch roleName {
 	case companyRole:
@@ -86,27 +81,25 @@ func (r *posSaleRepository) ReadAllPosSales(pagination dto.Pagination, roleName
 		return nil, errors.New("invalid role")
 	}
 
-	if pagination.Limit > 0 && pagination.Page > 0 {
-		offset := (pagination.Page - 1) * pagination.Limit
-		query = query.Offset(offset).Limit(pagination.Limit)
-	}
-
-	query.Find(&posSales)
-	query.Count(&totalRecords)
+	// A voided receipt keeps its sale rows, they are no longer sales
+	query = query.Where("sale_id NOT IN ?", r.voidedSales())
 
-	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+	return posSaleListSpec.readPage(query, pagination, listQuery, &posSales)
+}
 
-	return &dto.PaginationResult{
-		TotalRecords: totalRecords,
-		Records:      posSales,
-		CurrentPage:  pagination.Page,
-		TotalPages:   totalPages,
-	}, nil
+// voidedSales selects the sale rows of voided receipts through the receipt lines
+func (r *posSaleRepository) voidedSales() interface{} {
+	return r.db.Table("pos_receipt_lines AS l").
+		Select("l.sale_id").
+		Joins("JOIN pos_receipts AS v ON v.pos_receipt_id = l.pos_receipt_id").
+		Where("v.status = ?", entity.RECEIPT_STATUS_VOIDED).
+		SubQuery()
 }
 
 func (r *posSaleRepository) ReadPosSale(saleID string) (*pb.PosSale, error) {
 	// Try to get the sale from Redis first
 	saleData, err := r.redis.Get(context.Background(), saleID).Result()
+	metrics.ObserveCacheLookup("sale", err)
 	if err == redis.Nil {
 		// Sale not found in Redis, get from PostgreSQL
 		var posSaleEntity entity.PosSale
@@ -121,9 +114,12 @@ func (r *posSaleRepository) ReadPosSale(saleID string) (*pb.PosSale, error) {
 			ProductId:       posSaleEntity.ProductID.String(),
 			CustomerId:      posSaleEntity.CustomerID.String(),
 			Quantity:        int32(posSaleEntity.Quantity),
-			Price:           posSaleEntity.Price,
+			Price:           money.ToProto(posSaleEntity.Price),
 			SaleDate:        timestamppb.New(posSaleEntity.SaleDate),
-			TotalPrice:      posSaleEntity.TotalPrice,
+			TotalPrice:      money.ToProto(posSaleEntity.TotalPrice),
+			TaxAmount:       money.ToProto(posSaleEntity.TaxAmount),
+			DiscountAmount:  money.ToProto(posSaleEntity.DiscountAmount),
+			PromotionId:     posSaleEntity.PromotionID,
 			StoreId:         posSaleEntity.StoreID.String(),
 			CashierId:       posSaleEntity.CashierID.String(),
 			PaymentMethodId: posSaleEntity.PaymentMethodID.String(),
@@ -164,9 +160,12 @@ func (r *posSaleRepository) ReadPosSale(saleID string) (*pb.PosSale, error) {
 		ProductId:       posSaleEntity.ProductID.String(),
 		CustomerId:      posSaleEntity.CustomerID.String(),
 		Quantity:        int32(posSaleEntity.Quantity),
-		Price:           posSaleEntity.Price,
+		Price:           money.ToProto(posSaleEntity.Price),
 		SaleDate:        timestamppb.New(posSaleEntity.SaleDate),
-		TotalPrice:      posSaleEntity.TotalPrice,
+		TotalPrice:      money.ToProto(posSaleEntity.TotalPrice),
+		TaxAmount:       money.ToProto(posSaleEntity.TaxAmount),
+		DiscountAmount:  money.ToProto(posSaleEntity.DiscountAmount),
+		PromotionId:     posSaleEntity.PromotionID,
 		StoreId:         posSaleEntity.StoreID.String(),
 		CashierId:       posSaleEntity.CashierID.String(),
 		PaymentMethodId: posSaleEntity.PaymentMethodID.String(),
@@ -193,9 +192,12 @@ func (r *posSaleRepository) UpdatePosSale(posSale *entity.PosSale) (*pb.PosSale,
 		ProductId:       posSale.ProductID.String(),
 		CustomerId:      posSale.CustomerID.String(),
 		Quantity:        int32(posSale.Quantity),
-		Price:           posSale.Price,
+		Price:           money.ToProto(posSale.Price),
 		SaleDate:        timestamppb.New(posSale.SaleDate),
-		TotalPrice:      posSale.TotalPrice,
+		TotalPrice:      money.ToProto(posSale.TotalPrice),
+		TaxAmount:       money.ToProto(posSale.TaxAmount),
+		DiscountAmount:  money.ToProto(posSale.DiscountAmount),
+		PromotionId:     posSale.PromotionID,
 		StoreId:         posSale.StoreID.String(),
 		CashierId:       posSale.CashierID.String(),
 		PaymentMethodId: posSale.PaymentMethodID.String(),
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ROLLUP_REBUILD_BATCH_SIZE is the number of receipts read at a time when the rollups are rebuilt
+const ROLLUP_REBUILD_BATCH_SIZE = 500
+
+// receiptRollups returns what a receipt adds to the rollups, sign is 1 for a sale and -1 for its void.
+// The totals of the lines are split over the tenders in proportion to their amounts.
+func receiptRollups(posReceipt *entity.PosReceipt, sign int) []entity.PosSalesRollup {
+	if len(posReceipt.Tenders) == 0 {
+		return nil
+	}
+
+	var grossSales, discounts, taxes money.Amount
+	for _, line := range posReceipt.Lines {
+		grossSales += line.UnitPrice.Times(line.Quantity)
+		discounts += line.DiscountAmount
+		taxes += line.TaxAmount
+	}
+
+	weights := make([]money.Amount, len(posReceipt.Tenders))
+	var totalWeight money.Amount
+	for i, tender := range posReceipt.Tenders {
+		weights[i] = tender.Amount
+		totalWeight += tender.Amount
+	}
+	// A receipt that took nothing, fully discounted for one, is booked on its first tender
+	if totalWeight <= 0 {
+		weights = make([]money.Amount, len(posReceipt.Tenders))
+		weights[0] = 1
+	}
+
+	grossShares := grossSales.Allocate(weights)
+	discountShares := discounts.Allocate(weights)
+	taxShares := taxes.Allocate(weights)
+
+	bucketStart := posReceipt.ReceiptDate.UTC().Truncate(time.Hour)
+	signed := money.Amount(sign)
+
+	rollups := []entity.PosSalesRollup{}
+	byMethod := map[string]int{}
+	for i, tender := range posReceipt.Tenders {
+		index, ok := byMethod[tender.PaymentMethodID.String()]
+		if !ok {
+			index = len(rollups)
+			byMethod[tender.PaymentMethodID.String()] = index
+			rollups = append(rollups, entity.PosSalesRollup{
+				CompanyID:       posReceipt.CompanyID,
+				BranchID:        posReceipt.BranchID,
+				BucketStart:     bucketStart,
+				StoreID:         posReceipt.StoreID,
+				PaymentMethodID: tender.PaymentMethodID,
+			})
+		}
+
+		rollups[index].GrossSales += grossShares[i] * signed
+		rollups[index].Discounts += discountShares[i] * signed
+		rollups[index].Taxes += taxShares[i] * signed
+		if i == 0 {
+			rollups[index].Transactions += sign
+		}
+	}
+
+	return rollups
+}
+
+// returnRollup returns what a refund adds to the rollups, it is booked in the hour of the return
+func returnRollup(posReturn *entity.PosReturn) entity.PosSalesRollup {
+	return entity.PosSalesRollup{
+		CompanyID:       posReturn.CompanyID,
+		BranchID:        posReturn.BranchID,
+		BucketStart:     posReturn.ReturnDate.UTC().Truncate(time.Hour),
+		StoreID:         posReturn.StoreID,
+		PaymentMethodID: posReturn.PaymentMethodID,
+		Returns:         posReturn.Amount,
+		ReturnTaxes:     posReturn.TaxAmount,
+	}
+}
+
+// upsertPosSalesRollups adds the rollups to the stored ones, creating the rows that do not exist yet.
+// The rows are written in key order so concurrent transactions lock them in the same order and cannot deadlock.
+func upsertPosSalesRollups(tx *gorm.DB, rollups []entity.PosSalesRollup, now time.Time) error {
+	sort.Slice(rollups, func(i, j int) bool {
+		a, b := rollups[i], rollups[j]
+		if !a.BucketStart.Equal(b.BucketStart) {
+			return a.BucketStart.Before(b.BucketStart)
+		}
+		if a.StoreID != b.StoreID {
+			return bytes.Compare(a.StoreID[:], b.StoreID[:]) < 0
+		}
+		return bytes.Compare(a.PaymentMethodID[:], b.PaymentMethodID[:]) < 0
+	})
+
+	for _, rollup := range rollups {
+		err := tx.Exec(`INSERT INTO pos_sales_rollups
+			(company_id, branch_id, bucket_start, store_id, payment_method_id, gross_sales, discounts, taxes, returns, return_taxes, transactions, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (bucket_start, store_id, payment_method_id) DO UPDATE SET
+				gross_sales = pos_sales_rollups.gross_sales + EXCLUDED.gross_sales,
+				discounts = pos_sales_rollups.discounts + EXCLUDED.discounts,
+				taxes = pos_sales_rollups.taxes + EXCLUDED.taxes,
+				returns = pos_sales_rollups.returns + EXCLUDED.returns,
+				return_taxes = pos_sales_rollups.return_taxes + EXCLUDED.return_taxes,
+				transactions = pos_sales_rollups.transactions + EXCLUDED.transactions,
+				updated_at = EXCLUDED.updated_at`,
+			rollup.CompanyID, rollup.BranchID, rollup.BucketStart, rollup.StoreID, rollup.PaymentMethodID,
+			rollup.GrossSales, rollup.Discounts, rollup.Taxes, rollup.Returns, rollup.ReturnTaxes, rollup.Transactions, now,
+		).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergePosSalesRollups adds up the rollups of the same hour, store and payment method
+func mergePosSalesRollups(rollups []entity.PosSalesRollup) []entity.PosSalesRollup {
+	type rollupKey struct {
+		bucketStart     time.Time
+		storeID         string
+		paymentMethodID string
+	}
+
+	merged := []entity.PosSalesRollup{}
+	indexes := map[rollupKey]int{}
+	for _, rollup := range rollups {
+		key := rollupKey{rollup.BucketStart.UTC(), rollup.StoreID.String(), rollup.PaymentMethodID.String()}
+		index, ok := indexes[key]
+		if !ok {
+			indexes[key] = len(merged)
+			merged = append(merged, rollup)
+			continue
+		}
+
+		merged[index].GrossSales += rollup.GrossSales
+		merged[index].Discounts += rollup.Discounts
+		merged[index].Taxes += rollup.Taxes
+		merged[index].Returns += rollup.Returns
+		merged[index].ReturnTaxes += rollup.ReturnTaxes
+		merged[index].Transactions += rollup.Transactions
+	}
+
+	return merged
+}
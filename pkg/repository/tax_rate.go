@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosTaxRateRepository interface {
+	CreatePosTaxRate(posTaxRate *entity.PosTaxRate) error
+	ReadPosTaxRate(taxRateID string) (*pb.PosTaxRate, error)
+	UpdatePosTaxRate(posTaxRate *entity.PosTaxRate) (*pb.PosTaxRate, error)
+	DeletePosTaxRate(taxRateID string) error
+	ReadAllPosTaxRates(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReadPosTaxRatesByCompany(companyID string) ([]entity.PosTaxRate, error)
+}
+
+type posTaxRateRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+	roles config.RoleSettings
+}
+
+func NewPosTaxRateRepository(db *gorm.DB, redis *redis.Client, roles config.RoleSettings) PosTaxRateRepository {
+	return &posTaxRateRepository{
+		db:    db,
+		redis: redis,
+		roles: roles,
+	}
+}
+
+func (r *posTaxRateRepository) CreatePosTaxRate(posTaxRate *entity.PosTaxRate) error {
+	result := r.db.Create(posTaxRate)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *posTaxRateRepository) ReadPosTaxRate(taxRateID string) (*pb.PosTaxRate, error) {
+	// Try to get the tax rate from Redis first
+	taxRateData, err := r.redis.Get(context.Background(), taxRateID).Result()
+	metrics.ObserveCacheLookup("tax_rate", err)
+	if err == redis.Nil {
+		// Tax rate not found in Redis, get from PostgreSQL
+		var posTaxRateEntity entity.PosTaxRate
+		if err := r.db.Where("tax_rate_id = ?", taxRateID).First(&posTaxRateEntity).Error; err != nil {
+			return nil, err
+		}
+
+		// Store the tax rate in Redis for future queries
+		taxRateData, err := json.Marshal(posTaxRateEntity)
+		if err != nil {
+			return nil, err
+		}
+		err = r.redis.Set(context.Background(), taxRateID, taxRateData, 7*24*time.Hour).Err()
+		if err != nil {
+			return nil, err
+		}
+
+		return PosTaxRateToProto(&posTaxRateEntity), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Tax rate found in Redis, unmarshal the data
+	var posTaxRateEntity entity.PosTaxRate
+	err = json.Unmarshal([]byte(taxRateData), &posTaxRateEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return PosTaxRateToProto(&posTaxRateEntity), nil
+}
+
+func (r *posTaxRateRepository) UpdatePosTaxRate(posTaxRate *entity.PosTaxRate) (*pb.PosTaxRate, error) {
+	if err := r.db.Save(posTaxRate).Error; err != nil {
+		return nil, err
+	}
+
+	// Update the tax rate in Redis
+	taxRateData, err := json.Marshal(posTaxRate)
+	if err != nil {
+		return nil, err
+	}
+	err = r.redis.Set(context.Background(), posTaxRate.TaxRateID.String(), taxRateData, 7*24*time.Hour).Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return PosTaxRateToProto(posTaxRate), nil
+}
+
+func (r *posTaxRateRepository) DeletePosTaxRate(taxRateID string) error {
+	if err := r.db.Where("tax_rate_id = ?", taxRateID).Delete(&entity.PosTaxRate{}).Error; err != nil {
+		return err
+	}
+
+	// Delete the tax rate from Redis
+	err := r.redis.Del(context.Background(), taxRateID).Err()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// posTaxRateListSpec pages the tax rates in the order they were created
+var posTaxRateListSpec = listSpec{
+	dateColumn: "created_at",
+	keyColumn:  "tax_rate_id",
+}
+
+func (r *posTaxRateRepository) ReadAllPosTaxRates(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+	var posTaxRates []entity.PosTaxRate
+
+	query := r.db.Model(&entity.PosTaxRate{})
+
+	companyRole := r.roles.CompanyUserRole
+	branchRole := r.roles.BranchUserRole
+	storeRole := r.roles.StoreUserRole
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case storeRole:
+		query = query.Where("company_id = ? AND (store_id IS NULL OR store_id = ?)", jwtPayload.CompanyId, jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	return posTaxRateListSpec.readPage(query, pagination, nil, &posTaxRates)
+}
+
+// ReadPosTaxRatesByCompany returns every tax rate of a company, the tax engine picks the one that applies to a line
+func (r *posTaxRateRepository) ReadPosTaxRatesByCompany(companyID string) ([]entity.PosTaxRate, error) {
+	var posTaxRates []entity.PosTaxRate
+	if err := r.db.Where("company_id = ?", companyID).Find(&posTaxRates).Error; err != nil {
+		return nil, err
+	}
+	return posTaxRates, nil
+}
+
+// PosTaxRateToProto converts entity.PosTaxRate to pb.PosTaxRate
+func PosTaxRateToProto(posTaxRate *entity.PosTaxRate) *pb.PosTaxRate {
+	pbPosTaxRate := &pb.PosTaxRate{
+		TaxRateId:    posTaxRate.TaxRateID.String(),
+		TaxName:      posTaxRate.TaxName,
+		Rate:         posTaxRate.Rate,
+		TaxInclusive: posTaxRate.TaxInclusive,
+		CompanyId:    posTaxRate.CompanyID.String(),
+		CreatedAt:    timestamppb.New(posTaxRate.CreatedAt),
+		CreatedBy:    posTaxRate.CreatedBy.String(),
+		UpdatedAt:    timestamppb.New(posTaxRate.UpdatedAt),
+		UpdatedBy:    posTaxRate.UpdatedBy.String(),
+	}
+
+	if posTaxRate.StoreID != nil {
+		pbPosTaxRate.StoreId = posTaxRate.StoreID.String()
+	}
+
+	if posTaxRate.CategoryID != nil {
+		pbPosTaxRate.CategoryId = posTaxRate.CategoryID.String()
+	}
+
+	return pbPosTaxRate
+}
@@ -3,17 +3,21 @@ package service
 import (
 	"context"
 	"errors"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,38 +27,41 @@ type PosCashDrawerService interface {
 	UpdatePosCashDrawer(ctx context.Context, req *pb.UpdatePosCashDrawerRequest) (*pb.UpdatePosCashDrawerResponse, error)
 	DeletePosCashDrawer(ctx context.Context, req *pb.DeletePosCashDrawerRequest) (*pb.DeletePosCashDrawerResponse, error)
 	ReadAllPosCashDrawers(ctx context.Context, req *pb.ReadAllPosCashDrawersRequest) (*pb.ReadAllPosCashDrawersResponse, error)
+	OpenDrawerSession(ctx context.Context, req *pb.OpenDrawerSessionRequest) (*pb.OpenDrawerSessionResponse, error)
+	CloseDrawerSession(ctx context.Context, req *pb.CloseDrawerSessionRequest) (*pb.CloseDrawerSessionResponse, error)
+	ReadAllDrawerSessions(ctx context.Context, req *pb.ReadAllDrawerSessionsRequest) (*pb.ReadAllDrawerSessionsResponse, error)
 }
 
 type PosCashDrawerServiceServer struct {
 	pb.UnimplementedPosCashDrawerServiceServer
-	cashDrawerRepo     repository.PosCashDrawerRepository
-	CompanyServiceConn *grpc.ClientConn
+	cashDrawerRepo repository.PosCashDrawerRepository
+	sessionRepo    repository.PosDrawerSessionRepository
 }
 
-func NewPosCashDrawerServiceServer(cashDrawerRepo repository.PosCashDrawerRepository, companyServiceConn *grpc.ClientConn) *PosCashDrawerServiceServer {
+func NewPosCashDrawerServiceServer(cashDrawerRepo repository.PosCashDrawerRepository, sessionRepo repository.PosDrawerSessionRepository) *PosCashDrawerServiceServer {
 	return &PosCashDrawerServiceServer{
-		cashDrawerRepo:     cashDrawerRepo,
-		CompanyServiceConn: companyServiceConn,
+		cashDrawerRepo: cashDrawerRepo,
+		sessionRepo:    sessionRepo,
 	}
 }
 
 func (s *PosCashDrawerServiceServer) CreatePosCashDrawer(ctx context.Context, req *pb.CreatePosCashDrawerRequest) (*pb.CreatePosCashDrawerResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant create cash drawer")
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	req.PosCashDrawer.DrawerId = uuid.New().String() // Generate a new UUID for the drawer_id
 
+	if err := money.ValidateCurrency(req.PosCashDrawer.CashIn, req.PosCashDrawer.Amount, req.PosCashDrawer.CashOut); err != nil {
+		return nil, err
+	}
+
 	now := timestamppb.New(time.Now())
 	req.PosCashDrawer.CreatedAt = now
 	req.PosCashDrawer.UpdatedAt = now
@@ -63,37 +70,45 @@ func (s *PosCashDrawerServiceServer) CreatePosCashDrawer(ctx context.Context, re
 	gormCashDrawer := &entity.PosCashDrawer{
 		DrawerID:        uuid.MustParse(req.PosCashDrawer.DrawerId), // auto
 		StoreID:         nil,
-		EmployeeID:      uuid.MustParse(req.JwtPayload.UserId),
+		EmployeeID:      uuid.MustParse(identity.Payload.UserId),
 		ReceiptID:       req.PosCashDrawer.ReceiptId,
-		CashIn:          req.PosCashDrawer.CashIn,
-		Amount:          req.PosCashDrawer.Amount,
-		CashOut:         req.PosCashDrawer.CashOut,
+		CashIn:          money.FromProto(req.PosCashDrawer.CashIn),
+		Amount:          money.FromProto(req.PosCashDrawer.Amount),
+		CashOut:         money.FromProto(req.PosCashDrawer.CashOut),
 		TransactionTime: req.PosCashDrawer.TransactionTime.AsTime(), // auto
 		RoleID:          uuid.MustParse(req.PosCashDrawer.RoleId),
 		BranchID:        nil,
-		CompanyID:       uuid.MustParse(req.JwtPayload.CompanyId), // auto
+		CompanyID:       uuid.MustParse(identity.Payload.CompanyId), // auto
 		Description:     req.PosCashDrawer.Description,
-		CreatedAt:       req.PosCashDrawer.CreatedAt.AsTime(),  // auto
-		CreatedBy:       uuid.MustParse(req.JwtPayload.UserId), // auto
-		UpdatedAt:       req.PosCashDrawer.UpdatedAt.AsTime(),  // auto
-		UpdatedBy:       uuid.MustParse(req.JwtPayload.UserId), // auto
+		CreatedAt:       req.PosCashDrawer.CreatedAt.AsTime(),    // auto
+		CreatedBy:       uuid.MustParse(identity.Payload.UserId), // auto
+		UpdatedAt:       req.PosCashDrawer.UpdatedAt.AsTime(),    // auto
+		UpdatedBy:       uuid.MustParse(identity.Payload.UserId), // auto
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
 	// set Branch ID base in login role
-	switch loginRole.PosRole.RoleName {
-	case branchRole:
-		gormCashDrawer.BranchID = utils.ParseUUID(req.JwtPayload.BranchId)
+	switch access.Level {
+	case policy.BRANCH_USER:
+		gormCashDrawer.BranchID = utils.ParseUUID(identity.Payload.BranchId)
 		gormCashDrawer.StoreID = utils.ParseUUID(req.PosCashDrawer.StoreId)
 
 		if gormCashDrawer.StoreID == nil {
 			return nil, errors.New("error created cash drawer, store id could not be empty")
 		}
-	case storeRole:
-		gormCashDrawer.BranchID = utils.ParseUUID(req.JwtPayload.BranchId)
-		gormCashDrawer.StoreID = utils.ParseUUID(req.JwtPayload.StoreId)
+	case policy.STORE_USER:
+		gormCashDrawer.BranchID = utils.ParseUUID(identity.Payload.BranchId)
+		gormCashDrawer.StoreID = utils.ParseUUID(identity.Payload.StoreId)
+
+		// Cash moved by a cashier belongs to the cashier's open drawer session
+		session, err := s.sessionRepo.ReadOpenPosDrawerSession(identity.Payload.StoreId, identity.Payload.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			return nil, status.Error(codes.FailedPrecondition, "open a drawer session before moving cash")
+		}
+		gormCashDrawer.SessionID = &session.SessionID
+		req.PosCashDrawer.SessionId = session.SessionID.String()
 	}
 
 	err = s.cashDrawerRepo.CreatePosCashDrawer(gormCashDrawer)
@@ -101,32 +116,34 @@ func (s *PosCashDrawerServiceServer) CreatePosCashDrawer(ctx context.Context, re
 		return nil, err
 	}
 
+	if gormCashDrawer.StoreID != nil {
+		metrics.ObserveDrawerEntries(gormCashDrawer.StoreID.String(), "manual", 1)
+	}
+
 	return &pb.CreatePosCashDrawerResponse{
 		PosCashDrawer: req.PosCashDrawer,
 	}, nil
 }
 
 func (s *PosCashDrawerServiceServer) ReadAllPosCashDrawers(ctx context.Context, req *pb.ReadAllPosCashDrawersRequest) (*pb.ReadAllPosCashDrawersResponse, error) {
-	pagination := dto.Pagination{
-		Limit: int(req.Limit),
-		Page:  int(req.Page),
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read all cash drawer")
-	}
-
-	paginationResult, err := s.cashDrawerRepo.ReadAllPosCashDrawers(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	paginationResult, err := s.cashDrawerRepo.ReadAllPosCashDrawers(pagination, access.RoleName, identity.Payload, req.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -140,9 +157,9 @@ func (s *PosCashDrawerServiceServer) ReadAllPosCashDrawers(ctx context.Context,
 			StoreId:         posCashDrawer.StoreID.String(),
 			EmployeeId:      posCashDrawer.EmployeeID.String(),
 			ReceiptId:       posCashDrawer.ReceiptID,
-			CashIn:          posCashDrawer.CashIn,
-			Amount:          posCashDrawer.Amount,
-			CashOut:         posCashDrawer.CashOut,
+			CashIn:          money.ToProto(posCashDrawer.CashIn),
+			Amount:          money.ToProto(posCashDrawer.Amount),
+			CashOut:         money.ToProto(posCashDrawer.CashOut),
 			TransactionTime: timestamppb.New(posCashDrawer.TransactionTime),
 			RoleId:          posCashDrawer.RoleID.String(),
 			BranchId:        posCashDrawer.BranchID.String(),
@@ -153,6 +170,10 @@ func (s *PosCashDrawerServiceServer) ReadAllPosCashDrawers(ctx context.Context,
 			UpdatedAt:       timestamppb.New(posCashDrawer.UpdatedAt),
 			UpdatedBy:       posCashDrawer.UpdatedBy.String(),
 		}
+
+		if posCashDrawer.SessionID != nil {
+			pbPosCashDrawers[i].SessionId = posCashDrawer.SessionID.String()
+		}
 	}
 
 	return &pb.ReadAllPosCashDrawersResponse{
@@ -161,49 +182,24 @@ func (s *PosCashDrawerServiceServer) ReadAllPosCashDrawers(ctx context.Context,
 		Page:           int32(pagination.Page),
 		MaxPage:        int32(paginationResult.TotalPages),
 		Count:          paginationResult.TotalRecords,
+		NextPageToken:  paginationResult.NextPageToken,
+		CountEstimated: paginationResult.CountEstimated,
 	}, nil
 }
 
 func (s *PosCashDrawerServiceServer) ReadPosCashDrawer(ctx context.Context, req *pb.ReadPosCashDrawerRequest) (*pb.ReadPosCashDrawerResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read cash drawer")
-	}
-
 	posCashDrawer, err := s.cashDrawerRepo.ReadPosCashDrawer(req.DrawerId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posCashDrawer.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only retrieve cash drawer data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posCashDrawer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only retrieve cash drawer data within their branch")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posCashDrawer.StoreId, req.JwtPayload.StoreId) {
-			return nil, errors.New("store users can only retrieve cash drawer data within their store")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posCashDrawer.CompanyId, BranchID: posCashDrawer.BranchId, StoreID: posCashDrawer.StoreId, OwnerID: posCashDrawer.EmployeeId}); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPosCashDrawerResponse{
@@ -212,18 +208,14 @@ func (s *PosCashDrawerServiceServer) ReadPosCashDrawer(ctx context.Context, req
 }
 
 func (s *PosCashDrawerServiceServer) UpdatePosCashDrawer(ctx context.Context, req *pb.UpdatePosCashDrawerRequest) (*pb.UpdatePosCashDrawerResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant update cash drawer")
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the cash drawer to be updated
@@ -232,12 +224,12 @@ func (s *PosCashDrawerServiceServer) UpdatePosCashDrawer(ctx context.Context, re
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	if err := access.Authorize(policy.Resource{CompanyID: posCashDrawer.CompanyId, BranchID: posCashDrawer.BranchId, StoreID: posCashDrawer.StoreId, OwnerID: posCashDrawer.EmployeeId}); err != nil {
+		return nil, err
+	}
 
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posCashDrawer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only update cash drawer data within their branch")
-		}
+	if err := money.ValidateCurrency(req.PosCashDrawer.CashIn, req.PosCashDrawer.Amount, req.PosCashDrawer.CashOut); err != nil {
+		return nil, err
 	}
 
 	now := timestamppb.New(time.Now())
@@ -249,9 +241,9 @@ func (s *PosCashDrawerServiceServer) UpdatePosCashDrawer(ctx context.Context, re
 		StoreID:         nil,                                      // auto
 		EmployeeID:      uuid.MustParse(posCashDrawer.EmployeeId), // auto
 		ReceiptID:       posCashDrawer.ReceiptId,                  // aut0
-		CashIn:          req.PosCashDrawer.CashIn,
-		Amount:          req.PosCashDrawer.Amount,
-		CashOut:         req.PosCashDrawer.CashOut,
+		CashIn:          money.FromProto(req.PosCashDrawer.CashIn),
+		Amount:          money.FromProto(req.PosCashDrawer.Amount),
+		CashOut:         money.FromProto(req.PosCashDrawer.CashOut),
 		TransactionTime: posCashDrawer.TransactionTime.AsTime(),  // auto
 		RoleID:          uuid.MustParse(posCashDrawer.RoleId),    // auto
 		BranchID:        nil,                                     // auto
@@ -260,7 +252,7 @@ func (s *PosCashDrawerServiceServer) UpdatePosCashDrawer(ctx context.Context, re
 		CreatedAt:       posCashDrawer.CreatedAt.AsTime(),        // auto
 		CreatedBy:       uuid.MustParse(posCashDrawer.CreatedBy), // auto
 		UpdatedAt:       req.PosCashDrawer.UpdatedAt.AsTime(),    // auto
-		UpdatedBy:       uuid.MustParse(req.JwtPayload.UserId),   // auto
+		UpdatedBy:       uuid.MustParse(identity.Payload.UserId), // auto
 	}
 
 	// set store and branch id
@@ -279,32 +271,19 @@ func (s *PosCashDrawerServiceServer) UpdatePosCashDrawer(ctx context.Context, re
 }
 
 func (s *PosCashDrawerServiceServer) DeletePosCashDrawer(ctx context.Context, req *pb.DeletePosCashDrawerRequest) (*pb.DeletePosCashDrawerResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant delete cash drawer")
-	}
-
 	// Get the cash drawer to be updated
 	posCashDrawer, err := s.cashDrawerRepo.ReadPosCashDrawer(req.DrawerId)
 	if err != nil {
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posCashDrawer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only delete cash drawer data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posCashDrawer.CompanyId, BranchID: posCashDrawer.BranchId, StoreID: posCashDrawer.StoreId, OwnerID: posCashDrawer.EmployeeId}); err != nil {
+		return nil, err
 	}
 
 	// Delete the cash drawer
@@ -0,0 +1,610 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/pricing"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/taxes"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/tracing"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/upstream"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// posCheckout prices a basket, takes the payment and stores the receipt.
+// It is shared by the sale and receipt services so both RPCs produce the same records.
+type posCheckout struct {
+	checkoutRepo  repository.PosCheckoutRepository
+	paymentMethod repository.PosPaymentMethodRepository
+	customer      repository.PosCustomerRepository
+	taxRate       repository.PosTaxRateRepository
+	promotionRule repository.PosPromotionRuleRepository
+	drawerSession repository.PosDrawerSessionRepository
+	payments      config.PaymentSettings
+	upstream      *upstream.Client
+	logger        *slog.Logger
+}
+
+func newPosCheckout(checkoutRepo repository.PosCheckoutRepository, paymentMethod repository.PosPaymentMethodRepository, customer repository.PosCustomerRepository, taxRate repository.PosTaxRateRepository, promotionRule repository.PosPromotionRuleRepository, drawerSession repository.PosDrawerSessionRepository, payments config.PaymentSettings, upstreamClient *upstream.Client, logger *slog.Logger) *posCheckout {
+	return &posCheckout{
+		checkoutRepo:  checkoutRepo,
+		paymentMethod: paymentMethod,
+		customer:      customer,
+		taxRate:       taxRate,
+		promotionRule: promotionRule,
+		drawerSession: drawerSession,
+		payments:      payments,
+		upstream:      upstreamClient,
+		logger:        logger,
+	}
+}
+
+// run checks out the sale lines of req and returns the stored receipt.
+// The sale and receipt ids are written back into req.PosSales.
+// When the call holds an idempotency key it is completed with the response built by respond in the same transaction.
+func (c *posCheckout) run(ctx context.Context, req *pb.CreatePosSalesRequest, key *entity.PosIdempotencyKey, respond func(*entity.PosReceipt) proto.Message) (_ *entity.PosReceipt, err error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The remote lookups, the inventory calls and the receipt number reservation become children of this span
+	ctx, span := tracing.Start(ctx, "checkout", trace.WithAttributes(
+		attribute.String("pos.store_id", identity.Payload.GetStoreId()),
+		attribute.Int("pos.sale_lines", len(req.PosSales)),
+	))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	token := identity.Token
+
+	if len(req.PosSales) == 0 {
+		return nil, errors.New("sales transaction must contain at least one item")
+	}
+
+	var gormSales []*entity.PosSale
+	var getTotalDiscount money.Amount
+	var totalSalesAfterDiscount money.Amount
+
+	var itemList []dto.Items
+	var receiptLines []entity.PosReceiptLine
+	var lineTaxes []taxes.LineTax
+	var totalTax money.Amount
+	var exclusiveTax money.Amount
+	now := timestamppb.New(time.Now())
+	timeStamp := now
+
+	// A sale line without a payment method is booked on the first tender
+	var defaultPaymentMethodID string
+	if len(req.Tenders) > 0 {
+		defaultPaymentMethodID = req.Tenders[0].PaymentMethodId
+	}
+
+	taxRates, err := c.taxRate.ReadPosTaxRatesByCompany(identity.Payload.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	taxEngine := taxes.NewEngine(taxRates)
+
+	promotionRules, err := c.promotionRule.ReadActivePosPromotionRules(identity.Payload.CompanyId, now.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	// Look up every product first, nothing is written until all lookups succeed
+	var products []*pb.PosProduct
+	var pricingLines []*pricing.Line
+	for _, posSale := range req.PosSales {
+		posSale.SaleId = uuid.New().String() // Generate a new UUID for the sale_id
+
+		if posSale.PaymentMethodId == "" {
+			posSale.PaymentMethodId = defaultPaymentMethodID
+		}
+
+		if posSale.PaymentMethodId == "" {
+			return nil, errors.New("sales transaction must have a payment method")
+		}
+
+		if _, err := uuid.Parse(posSale.PaymentMethodId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "payment method id %q is not a valid UUID", posSale.PaymentMethodId)
+		}
+
+		if _, err := uuid.Parse(posSale.CustomerId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "customer id %q is not a valid UUID", posSale.CustomerId)
+		}
+
+		if posSale.Quantity <= 0 {
+			return nil, errors.New("sales quantity must be greater than zero")
+		}
+
+		// set current time stamp
+		posSale.CreatedAt = timeStamp
+		posSale.UpdatedAt = timeStamp
+		posSale.SaleDate = timeStamp
+
+		// get prodict data from Product Service
+		productData, err := c.upstream.GetPosProductByBarcode(ctx, posSale.ProductId, identity.Payload, token)
+		if err != nil {
+			return nil, err
+		}
+
+		// Promotions of the product service take part in the pricing as percentage rules
+		promotionData, err := c.upstream.GetPosPromotionByProductId(ctx, productData.PosProduct.ProductId, identity.Payload, identity.Token)
+		if err != nil {
+			c.logger.WarnContext(ctx, "promotion lookup failed, pricing the product without it",
+				slog.String("product_id", productData.PosProduct.ProductId),
+				slog.String("error", err.Error()))
+		} else if rule := pricing.LegacyRule(promotionData.GetPosPromotion()); rule != nil {
+			promotionRules = append(promotionRules, *rule)
+		}
+
+		products = append(products, productData.PosProduct)
+		pricingLines = append(pricingLines, &pricing.Line{
+			ProductID: productData.PosProduct.ProductId,
+			UnitPrice: money.FromFloat(productData.PosProduct.Price),
+			Quantity:  int(posSale.Quantity),
+		})
+	}
+
+	// Price the whole basket at once so bundles and receipt thresholds see every line
+	pricingEngine := pricing.NewEngine(promotionRules, identity.Payload.StoreId, identity.Payload.BranchId, now.AsTime())
+	receiptPromotionID := pricingEngine.Price(pricingLines)
+
+	var subTotalSales money.Amount
+	for i, posSale := range req.PosSales {
+		productData := products[i]
+		line := pricingLines[i]
+
+		// Price is the list price, the line total is what is left after its discounts
+		posSale.Price = money.ToProto(line.UnitPrice)
+		posSale.DiscountAmount = money.ToProto(line.Discount)
+		posSale.PromotionId = line.PromotionID
+		posSale.TotalPrice = money.ToProto(line.Net())
+
+		subTotalSales += line.Gross
+		getTotalDiscount += line.Discount
+
+		// Tax the line with the rate of its store and product category
+		tax := taxEngine.Compute(identity.Payload.StoreId, productData.CategoryId, line.Net())
+		posSale.TaxAmount = money.ToProto(tax.Amount)
+		totalTax += tax.Amount
+		if tax.TaxRate != nil && !tax.TaxRate.TaxInclusive {
+			exclusiveTax += tax.Amount
+		}
+
+		// Convert pb.PosSale to entity.PosSale
+		gormSale := &entity.PosSale{
+			SaleID:          uuid.MustParse(posSale.SaleId), // auto
+			ProductID:       uuid.MustParse(productData.ProductId),
+			CustomerID:      uuid.MustParse(posSale.CustomerId),
+			Quantity:        int(posSale.Quantity),
+			Price:           line.UnitPrice,                           // auto
+			SaleDate:        posSale.SaleDate.AsTime(),                // auto
+			TotalPrice:      line.Net(),                               // auto
+			TaxAmount:       tax.Amount,                               // auto
+			DiscountAmount:  line.Discount,                            // auto
+			PromotionID:     posSale.PromotionId,                      // auto
+			StoreID:         uuid.MustParse(identity.Payload.StoreId), // auto
+			CashierID:       uuid.MustParse(identity.Payload.UserId),  // auto
+			PaymentMethodID: uuid.MustParse(posSale.PaymentMethodId),
+			BranchID:        uuid.MustParse(identity.Payload.BranchId),  // auto
+			CompanyID:       uuid.MustParse(identity.Payload.CompanyId), // auto
+			CreatedAt:       posSale.CreatedAt.AsTime(),                 // auto
+			CreatedBy:       uuid.MustParse(identity.Payload.UserId),    // auto
+			UpdatedAt:       posSale.UpdatedAt.AsTime(),                 // auto
+			UpdatedBy:       uuid.MustParse(identity.Payload.UserId),    // auto
+		}
+
+		item := dto.Items{
+			ProductName: productData.ProductName,
+			Quantity:    int(posSale.Quantity),
+			Price:       gormSale.Price,
+			TotalPrice:  gormSale.TotalPrice,
+		}
+
+		receiptLine := entity.PosReceiptLine{
+			LineID:         uuid.New(),
+			SaleID:         gormSale.SaleID,
+			ProductID:      gormSale.ProductID,
+			ProductName:    productData.ProductName,
+			Quantity:       gormSale.Quantity,
+			UnitPrice:      gormSale.Price,
+			DiscountAmount: gormSale.DiscountAmount,
+			TotalPrice:     gormSale.TotalPrice,
+			TaxAmount:      tax.Amount,
+			PromotionID:    gormSale.PromotionID,
+		}
+
+		if tax.TaxRate != nil {
+			receiptLine.TaxRateID = &tax.TaxRate.TaxRateID
+			receiptLine.TaxRate = tax.TaxRate.Rate
+			receiptLine.TaxInclusive = tax.TaxRate.TaxInclusive
+		}
+
+		itemList = append(itemList, item)
+		receiptLines = append(receiptLines, receiptLine)
+		lineTaxes = append(lineTaxes, tax)
+		gormSales = append(gormSales, gormSale)
+	}
+
+	// The line totals already carry their discounts, so the discount is taken off the gross subtotal once.
+	// Tax exclusive prices get their tax added on top.
+	totalSalesAfterDiscount = subTotalSales - getTotalDiscount + exclusiveTax
+
+	// Without tenders the whole receipt is paid with the payment method of the first line
+	tenders := req.Tenders
+	if len(tenders) == 0 {
+		tenders = []*pb.PosTender{
+			{
+				PaymentMethodId: req.PosSales[0].PaymentMethodId,
+				Amount:          money.ToProto(totalSalesAfterDiscount),
+			},
+		}
+	}
+
+	checkoutTenders, err := c.resolveTenders(tenders, totalSalesAfterDiscount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the receipt details before any side effect so a failing lookup does not leave a half written sale
+	userData, err := c.upstream.GetPosUserById(ctx, identity.Payload.UserId, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	storeData, err := c.upstream.GetPosStoreById(ctx, identity.Payload.StoreId, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := c.customer.ReadPosCustomer(gormSales[0].CustomerID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Cash taken by the cashier is booked on the cashier's open drawer session
+	drawerSession, err := c.drawerSession.ReadOpenPosDrawerSession(identity.Payload.StoreId, identity.Payload.UserId)
+	if err != nil {
+		return nil, err
+	}
+	for _, tender := range checkoutTenders {
+		if tender.isCash && drawerSession == nil {
+			return nil, status.Error(codes.FailedPrecondition, "open a drawer session before taking cash")
+		}
+	}
+
+	saga := newCheckoutSaga()
+	// Compensations still have to run when the caller has gone away, they keep the request id but not the cancellation
+	compensationCtx := context.WithoutCancel(ctx)
+
+	// Reserve a new receipt number from the Store service
+	storeID := identity.Payload.StoreId
+	nextReceiptID, err := c.upstream.GetNextReceiptID(ctx, storeID, token)
+	if err != nil {
+		return nil, err
+	}
+	receiptID := strconv.Itoa(nextReceiptID.Data.ReceiptID)
+	saga.addCompensation("release receipt id "+receiptID, func() error {
+		return c.upstream.ReleaseReceiptID(compensationCtx, storeID, receiptID, token)
+	})
+
+	for i, gormSale := range gormSales {
+		gormSale.ReceiptID = receiptID
+		req.PosSales[i].ReceiptId = receiptID
+	}
+
+	// Create record stock out into inventory history
+	for _, gormSale := range gormSales {
+		inventoryHistory := &dto.PosInventoryHistory{
+			ProductId: gormSale.ProductID.String(),
+			StoreId:   gormSale.StoreID.String(),
+			Quantity:  -int32(gormSale.Quantity),
+			BranchId:  gormSale.BranchID.String(),
+		}
+
+		_, err = c.upstream.CreatePosInventoryHistory(ctx, inventoryHistory, identity.Payload, token)
+		if err != nil {
+			return nil, saga.abort(err)
+		}
+
+		// Put the stock back if a later step fails
+		restock := &dto.PosInventoryHistory{
+			ProductId: inventoryHistory.ProductId,
+			StoreId:   inventoryHistory.StoreId,
+			Quantity:  int32(gormSale.Quantity),
+			BranchId:  inventoryHistory.BranchId,
+		}
+		saga.addCompensation("restock product "+restock.ProductId, func() error {
+			_, err := c.upstream.CreatePosInventoryHistory(compensationCtx, restock, identity.Payload, token)
+			return err
+		})
+	}
+
+	posReceiptID := uuid.New()
+	for i := range receiptLines {
+		receiptLines[i].PosReceiptID = posReceiptID
+	}
+
+	posReceipt := &entity.PosReceipt{
+		PosReceiptID:       posReceiptID,
+		ReceiptID:          receiptID,
+		StoreID:            uuid.MustParse(identity.Payload.StoreId),
+		BranchID:           uuid.MustParse(identity.Payload.BranchId),
+		CompanyID:          uuid.MustParse(identity.Payload.CompanyId),
+		CustomerID:         gormSales[0].CustomerID,
+		CashierID:          uuid.MustParse(identity.Payload.UserId),
+		Status:             entity.RECEIPT_STATUS_COMPLETED,
+		SubTotal:           subTotalSales,
+		DiscountTotal:      getTotalDiscount,
+		TaxTotal:           totalTax,
+		Total:              totalSalesAfterDiscount,
+		CurrencyCode:       money.Currency(),
+		ReceiptPromotionID: receiptPromotionID,
+		ReceiptDate:        now.AsTime(),
+		Lines:              receiptLines,
+		CreatedAt:          now.AsTime(),
+		CreatedBy:          uuid.MustParse(identity.Payload.UserId),
+		UpdatedAt:          now.AsTime(),
+		UpdatedBy:          uuid.MustParse(identity.Payload.UserId),
+	}
+
+	checkout := &dto.PosCheckout{
+		Receipt: posReceipt,
+		Sales:   gormSales,
+	}
+
+	payLaterMethod := c.payments.PayLaterMethod
+
+	// The receipt discount and tax are split over the tenders by amount, an invoice records the shares of its tender
+	tenderAmounts := make([]money.Amount, len(checkoutTenders))
+	for i, tender := range checkoutTenders {
+		tenderAmounts[i] = tender.amount
+	}
+	discountShares := tenderShares(getTotalDiscount, tenderAmounts)
+	taxShares := tenderShares(totalTax, tenderAmounts)
+
+	// Every tender gets its own cash drawer, invoice or online payment record
+	for i, tender := range checkoutTenders {
+		paymentMethodData := tender.paymentMethod
+
+		posReceipt.Tenders = append(posReceipt.Tenders, entity.PosReceiptTender{
+			TenderID:          uuid.New(),
+			PosReceiptID:      posReceiptID,
+			PaymentMethodID:   uuid.MustParse(paymentMethodData.PaymentMethodId),
+			PaymentMethodName: paymentMethodData.MethodName,
+			Amount:            tender.amount,
+			CashTendered:      tender.cashTendered,
+			ChangeAmount:      tender.change,
+		})
+		posReceipt.CashTendered += tender.cashTendered
+		posReceipt.ChangeAmount += tender.change
+
+		// If payment method cash --> cash drawer, the customer's cash goes in and the change goes out
+		if tender.isCash {
+			cashDrawerData := &entity.PosCashDrawer{
+				DrawerID:        uuid.New(),
+				StoreID:         nil,
+				EmployeeID:      uuid.MustParse(identity.Payload.UserId),
+				ReceiptID:       receiptID,
+				CashIn:          tender.cashTendered,
+				Amount:          tender.amount,
+				CashOut:         tender.change,
+				TransactionTime: now.AsTime(),
+				RoleID:          uuid.MustParse(identity.Payload.Role),
+				BranchID:        nil,
+				CompanyID:       uuid.MustParse(identity.Payload.CompanyId),
+				Description:     fmt.Sprintf("Sales Receipt ID %s", receiptID),
+				CreatedAt:       now.AsTime(),
+				CreatedBy:       uuid.MustParse(identity.Payload.UserId),
+				UpdatedAt:       now.AsTime(),
+				UpdatedBy:       uuid.MustParse(identity.Payload.UserId),
+			}
+			cashDrawerData.StoreID = utils.ParseUUID(identity.Payload.StoreId)
+			cashDrawerData.BranchID = utils.ParseUUID(identity.Payload.BranchId)
+			cashDrawerData.SessionID = &drawerSession.SessionID
+			checkout.CashDrawers = append(checkout.CashDrawers, cashDrawerData)
+			// if payment method pay later
+		} else if paymentMethodData.MethodName == payLaterMethod {
+			invoiceData := &entity.PosInvoice{
+				InvoiceID: uuid.New(),
+				ReceiptID: receiptID,
+				Date:      now.AsTime(),
+				Amount:    tender.amount,
+				Discounts: discountShares[i],
+				Taxes:     taxShares[i],
+				BranchID:  uuid.MustParse(identity.Payload.BranchId),
+				CompanyID: uuid.MustParse(identity.Payload.CompanyId),
+				CreatedAt: now.AsTime(),
+				CreatedBy: uuid.MustParse(identity.Payload.UserId),
+				UpdatedAt: now.AsTime(),
+				UpdatedBy: uuid.MustParse(identity.Payload.UserId),
+			}
+			checkout.Invoices = append(checkout.Invoices, invoiceData)
+		} else {
+			// if payment method not cash or pay later
+			onlinePaymentData := &entity.PosOnlinePayment{
+				PaymentID:     uuid.New(),
+				StoreID:       uuid.MustParse(identity.Payload.StoreId),
+				EmployeeID:    uuid.MustParse(identity.Payload.UserId),
+				PaymentDate:   now.AsTime(),
+				ReceiptID:     receiptID,
+				Amount:        tender.amount,
+				PaymentMethod: uuid.MustParse(paymentMethodData.PaymentMethodId),
+				RoleID:        uuid.MustParse(identity.Payload.Role),
+				BranchID:      uuid.MustParse(identity.Payload.BranchId),
+				CompanyID:     uuid.MustParse(identity.Payload.CompanyId),
+				CreatedAt:     now.AsTime(),
+				CreatedBy:     uuid.MustParse(identity.Payload.UserId),
+				UpdatedAt:     now.AsTime(),
+				UpdatedBy:     uuid.MustParse(identity.Payload.UserId),
+			}
+			checkout.OnlinePayments = append(checkout.OnlinePayments, onlinePaymentData)
+		}
+	}
+
+	receipt := dto.DigitalReceipt{
+		Receiver: dto.EmailReceiver{
+			EmailAddress: customer.Email,
+		},
+		Header: dto.HeaderReceipt{
+			StoreName:           storeData.PosStore.StoreName,
+			StoreAddress:        storeData.PosStore.Location,
+			CashierName:         userData.PosUser.Username,
+			ReceiptID:           receiptID,
+			TransactionDateTime: timeStamp.String(),
+		},
+		Body: dto.BodyReceipt{
+			Items: itemList,
+		},
+		Summary: dto.SummaryReceipt{
+			SubTotalAmount: subTotalSales,
+			DiscountAmoutn: getTotalDiscount,
+			TaxAmount:      totalTax,
+			TaxBreakdown:   taxes.Breakdown(lineTaxes),
+			TotalAmount:    totalSalesAfterDiscount,
+			CashAmount:     posReceipt.CashTendered,
+			ChangeAmount:   posReceipt.ChangeAmount,
+			CurrencyCode:   posReceipt.CurrencyCode,
+		},
+	}
+
+	// Queue the digital receipt in the outbox, it is published after the sale is committed
+	receiptMessage, err := newOutboxMessage(ctx, receiptID, DIGITAL_RECEIPT_EVENT, DIGITAL_RECEIPT_QUEUE, receipt, now.AsTime())
+	if err != nil {
+		return nil, saga.abort(err)
+	}
+
+	checkout.OutboxMessages = append(checkout.OutboxMessages, receiptMessage)
+
+	if key != nil {
+		if err := completeIdempotencyKey(key, respond(posReceipt)); err != nil {
+			return nil, saga.abort(err)
+		}
+		checkout.IdempotencyKey = key
+	}
+
+	// insert the receipt, the sales, their tender record, the outgoing events and the idempotency key in one transaction
+	_, commitSpan := tracing.Start(ctx, "checkout.commit")
+	err = c.checkoutRepo.CreatePosCheckout(checkout)
+	tracing.End(commitSpan, err)
+	if err != nil {
+		return nil, saga.abort(err)
+	}
+
+	metrics.ObserveSale(storeID)
+	for _, tender := range checkoutTenders {
+		metrics.ObserveSaleTender(storeID, tender.paymentMethod.MethodName, tender.amount)
+	}
+	metrics.ObserveDrawerEntries(storeID, "sale", len(checkout.CashDrawers))
+
+	return posReceipt, nil
+}
+
+// checkoutTender is a requested tender together with its payment method.
+// For cash tenders cashTendered is what the customer handed over and change is what goes back.
+type checkoutTender struct {
+	amount        money.Amount
+	cashTendered  money.Amount
+	change        money.Amount
+	isCash        bool
+	paymentMethod *pb.PosPaymentMethod
+}
+
+// resolveTenders loads the payment method of every tender and checks that the tenders pay the receipt total exactly
+func (c *posCheckout) resolveTenders(tenders []*pb.PosTender, total money.Amount) ([]checkoutTender, error) {
+	var checkoutTenders []checkoutTender
+	var tenderTotal money.Amount
+
+	cashMethod := c.payments.CashMethod
+
+	for _, tender := range tenders {
+		if _, err := uuid.Parse(tender.PaymentMethodId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "tender payment method id %q is not a valid UUID", tender.PaymentMethodId)
+		}
+
+		if err := money.ValidateCurrency(tender.Amount, tender.CashTendered); err != nil {
+			return nil, err
+		}
+
+		amount := money.FromProto(tender.Amount)
+		cashTendered := money.FromProto(tender.CashTendered)
+		if amount < 0 || cashTendered < 0 {
+			return nil, errors.New("tender amount cannot be negative")
+		}
+
+		paymentMethodData, err := c.paymentMethod.ReadPosPaymentMethod(tender.PaymentMethodId)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved := checkoutTender{
+			amount:        amount,
+			isCash:        paymentMethodData.MethodName == cashMethod,
+			paymentMethod: paymentMethodData,
+		}
+
+		if resolved.isCash {
+			// Without a tendered amount the customer paid the exact amount
+			resolved.cashTendered = amount
+			if cashTendered != 0 {
+				resolved.cashTendered = cashTendered
+			}
+
+			if resolved.cashTendered < amount {
+				return nil, fmt.Errorf("cash tendered %s is less than the cash amount due %s", resolved.cashTendered, amount)
+			}
+
+			resolved.change = resolved.cashTendered - amount
+		} else if cashTendered != 0 {
+			return nil, fmt.Errorf("cash tendered is only allowed for %s payments", cashMethod)
+		}
+
+		tenderTotal += amount
+		checkoutTenders = append(checkoutTenders, resolved)
+	}
+
+	if tenderTotal != total {
+		return nil, fmt.Errorf("tenders total %s does not match receipt total %s", tenderTotal, total)
+	}
+
+	return checkoutTenders, nil
+}
+
+// tenderShares splits a receipt amount over the tenders in proportion to what they paid.
+// A receipt that took nothing, fully discounted for one, books it on its first tender.
+func tenderShares(amount money.Amount, tenderAmounts []money.Amount) []money.Amount {
+	weights := make([]money.Amount, len(tenderAmounts))
+	var totalWeight money.Amount
+	for i, tenderAmount := range tenderAmounts {
+		weights[i] = tenderAmount
+		totalWeight += tenderAmount
+	}
+
+	if totalWeight <= 0 && len(weights) > 0 {
+		weights = make([]money.Amount, len(tenderAmounts))
+		weights[0] = 1
+	}
+
+	return amount.Allocate(weights)
+}
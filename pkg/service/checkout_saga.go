@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// checkoutCompensation undoes a remote step that already succeeded during a checkout
+type checkoutCompensation struct {
+	name   string
+	action func() error
+}
+
+// checkoutSaga records the compensating actions of the remote checkout steps
+// (receipt number reservation, inventory stock out) so they can be replayed
+// in reverse order when a later step fails.
+type checkoutSaga struct {
+	compensations []checkoutCompensation
+}
+
+func newCheckoutSaga() *checkoutSaga {
+	return &checkoutSaga{}
+}
+
+// addCompensation registers the action that reverts the step that just succeeded
+func (s *checkoutSaga) addCompensation(name string, action func() error) {
+	s.compensations = append(s.compensations, checkoutCompensation{
+		name:   name,
+		action: action,
+	})
+}
+
+// compensate replays every recorded compensation from the newest to the oldest.
+// A failing compensation does not stop the remaining ones, all failures are returned together.
+func (s *checkoutSaga) compensate() error {
+	var errs []error
+	for i := len(s.compensations) - 1; i >= 0; i-- {
+		compensation := s.compensations[i]
+		if err := compensation.action(); err != nil {
+			errs = append(errs, fmt.Errorf("compensation %q failed: %w", compensation.name, err))
+		}
+	}
+	s.compensations = nil
+	return errors.Join(errs...)
+}
+
+// abort runs the compensations and reports the original failure together with any compensation failure
+func (s *checkoutSaga) abort(cause error) error {
+	if err := s.compensate(); err != nil {
+		return fmt.Errorf("%w (checkout rollback incomplete: %v)", cause, err)
+	}
+	return cause
+}
@@ -2,18 +2,16 @@ package service
 
 import (
 	"context"
-	"errors"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
-	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,32 +25,21 @@ type PosCustomerService interface {
 
 type posCustomerService struct {
 	pb.UnimplementedPosCustomerServiceServer
-	customerRepo       repository.PosCustomerRepository
-	CompanyServiceConn *grpc.ClientConn
+	customerRepo repository.PosCustomerRepository
 }
 
-func NewPosCustomerService(customerRepo repository.PosCustomerRepository, companyServiceConn *grpc.ClientConn) *posCustomerService {
+func NewPosCustomerService(customerRepo repository.PosCustomerRepository) *posCustomerService {
 	return &posCustomerService{
-		customerRepo:       customerRepo,
-		CompanyServiceConn: companyServiceConn,
+		customerRepo: customerRepo,
 	}
 }
 
 func (s *posCustomerService) CreatePosCustomer(ctx context.Context, req *pb.CreatePosCustomerRequest) (*pb.CreatePosCustomerResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant create customer data")
-	}
-
 	req.PosCustomer.CustomerId = uuid.New().String() // Generate a new UUID for the customer_id
 
 	dateOfBirth, err := time.Parse("2006-01-02", req.PosCustomer.DateOfBirth)
@@ -77,12 +64,12 @@ func (s *posCustomerService) CreatePosCustomer(ctx context.Context, req *pb.Crea
 		Address:          req.PosCustomer.Address,
 		City:             req.PosCustomer.City,
 		Country:          req.PosCustomer.Country,
-		BranchID:         uuid.MustParse(req.JwtPayload.BranchId),  // auto
-		CompanyID:        uuid.MustParse(req.JwtPayload.CompanyId), // auto
-		CreatedAt:        req.PosCustomer.CreatedAt.AsTime(),       //  auto
-		CreatedBy:        uuid.MustParse(req.JwtPayload.UserId),    // auto
-		UpdatedAt:        req.PosCustomer.UpdatedAt.AsTime(),       // auto
-		UpdatedBy:        uuid.MustParse(req.JwtPayload.UserId),    // auto
+		BranchID:         uuid.MustParse(identity.Payload.BranchId),  // auto
+		CompanyID:        uuid.MustParse(identity.Payload.CompanyId), // auto
+		CreatedAt:        req.PosCustomer.CreatedAt.AsTime(),         //  auto
+		CreatedBy:        uuid.MustParse(identity.Payload.UserId),    // auto
+		UpdatedAt:        req.PosCustomer.UpdatedAt.AsTime(),         // auto
+		UpdatedBy:        uuid.MustParse(identity.Payload.UserId),    // auto
 	}
 
 	err = s.customerRepo.CreatePosCustomer(gormCustomer)
@@ -96,26 +83,24 @@ func (s *posCustomerService) CreatePosCustomer(ctx context.Context, req *pb.Crea
 }
 
 func (s *posCustomerService) ReadAllPosCustomers(ctx context.Context, req *pb.ReadAllPosCustomersRequest) (*pb.ReadAllPosCustomersResponse, error) {
-	pagination := dto.Pagination{
-		Limit: int(req.Limit),
-		Page:  int(req.Page),
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read all customer data")
-	}
-
-	paginationResult, err := s.customerRepo.ReadAllPosCustomers(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	paginationResult, err := s.customerRepo.ReadAllPosCustomers(pagination, access.RoleName, identity.Payload, req.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -145,54 +130,29 @@ func (s *posCustomerService) ReadAllPosCustomers(ctx context.Context, req *pb.Re
 	}
 
 	return &pb.ReadAllPosCustomersResponse{
-		PosCustomers: pbPosCustomers,
-		Limit:        int32(pagination.Limit),
-		Page:         int32(pagination.Page),
-		MaxPage:      int32(paginationResult.TotalPages),
-		Count:        paginationResult.TotalRecords,
+		PosCustomers:   pbPosCustomers,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
+		NextPageToken:  paginationResult.NextPageToken,
+		CountEstimated: paginationResult.CountEstimated,
 	}, nil
 }
 
 func (s *posCustomerService) ReadPosCustomer(ctx context.Context, req *pb.ReadPosCustomerRequest) (*pb.ReadPosCustomerResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read customer data")
-	}
-
 	posCustomer, err := s.customerRepo.ReadPosCustomer(req.CustomerId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posCustomer.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only retrieve customer data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posCustomer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only retrieve customer data within their branch")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posCustomer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("store users can only retrieve customer data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posCustomer.CompanyId, BranchID: posCustomer.BranchId}); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPosCustomerResponse{
@@ -201,19 +161,14 @@ func (s *posCustomerService) ReadPosCustomer(ctx context.Context, req *pb.ReadPo
 }
 
 func (s *posCustomerService) UpdatePosCustomer(ctx context.Context, req *pb.UpdatePosCustomerRequest) (*pb.UpdatePosCustomerResponse, error) {
-	// Get the role name from the role ID in the JWT payload
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant update customer data")
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the customer to be updated
@@ -222,19 +177,8 @@ func (s *posCustomerService) UpdatePosCustomer(ctx context.Context, req *pb.Upda
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posCustomer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only update customer data within their branch")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posCustomer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("store users can only update customer data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posCustomer.CompanyId, BranchID: posCustomer.BranchId}); err != nil {
+		return nil, err
 	}
 
 	dateOfBirth, err := time.Parse("2006-01-02", req.PosCustomer.DateOfBirth)
@@ -261,7 +205,7 @@ func (s *posCustomerService) UpdatePosCustomer(ctx context.Context, req *pb.Upda
 		CreatedAt:        posCustomer.CreatedAt.AsTime(),
 		CreatedBy:        uuid.MustParse(posCustomer.CreatedBy),
 		UpdatedAt:        req.PosCustomer.UpdatedAt.AsTime(),
-		UpdatedBy:        uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedBy:        uuid.MustParse(identity.Payload.UserId),
 	}
 
 	// Update the customer
@@ -275,39 +219,19 @@ func (s *posCustomerService) UpdatePosCustomer(ctx context.Context, req *pb.Upda
 	}, nil
 }
 func (s *posCustomerService) DeletePosCustomer(ctx context.Context, req *pb.DeletePosCustomerRequest) (*pb.DeletePosCustomerResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant delete sales data")
-	}
-
 	// Get the customer to be updated
 	posCustomer, err := s.customerRepo.ReadPosCustomer(req.CustomerId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posCustomer.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only delete customer data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posCustomer.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only delete customer data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posCustomer.CompanyId, BranchID: posCustomer.BranchId}); err != nil {
+		return nil, err
 	}
 
 	// Delete the customer
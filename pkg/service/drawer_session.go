@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+
+	"github.com/google/uuid"
+)
+
+// OpenDrawerSession starts a shift for the logged in cashier with the float put in the drawer
+func (s *PosCashDrawerServiceServer) OpenDrawerSession(ctx context.Context, req *pb.OpenDrawerSessionRequest) (*pb.OpenDrawerSessionResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := money.ValidateCurrency(req.OpeningFloat); err != nil {
+		return nil, err
+	}
+
+	if money.FromProto(req.OpeningFloat) < 0 {
+		return nil, errors.New("opening float cannot be negative")
+	}
+
+	now := time.Now()
+	userID := uuid.MustParse(identity.Payload.UserId)
+
+	posDrawerSession := &entity.PosDrawerSession{
+		SessionID:    uuid.New(),
+		StoreID:      uuid.MustParse(identity.Payload.StoreId),
+		BranchID:     uuid.MustParse(identity.Payload.BranchId),
+		CompanyID:    uuid.MustParse(identity.Payload.CompanyId),
+		CashierID:    userID,
+		Status:       entity.DRAWER_SESSION_OPEN,
+		OpeningFloat: money.FromProto(req.OpeningFloat),
+		OpenedAt:     now,
+		CreatedAt:    now,
+		CreatedBy:    userID,
+		UpdatedAt:    now,
+		UpdatedBy:    userID,
+	}
+
+	err = s.sessionRepo.CreatePosDrawerSession(posDrawerSession)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.OpenDrawerSessionResponse{
+		PosDrawerSession: repository.PosDrawerSessionToProto(posDrawerSession),
+	}, nil
+}
+
+// CloseDrawerSession records the blind count of the drawer and reconciles it with the session's ledger.
+// Cashiers count without seeing the expected cash, so the expected amount and the variance are only returned to branch users.
+func (s *PosCashDrawerServiceServer) CloseDrawerSession(ctx context.Context, req *pb.CloseDrawerSessionRequest) (*pb.CloseDrawerSessionResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	posDrawerSession, err := s.sessionRepo.ReadPosDrawerSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posDrawerSession.CompanyID.String(), BranchID: posDrawerSession.BranchID.String(), StoreID: posDrawerSession.StoreID.String(), OwnerID: posDrawerSession.CashierID.String()}); err != nil {
+		return nil, err
+	}
+
+	if len(req.Denominations) == 0 {
+		return nil, errors.New("drawer count must contain at least one denomination")
+	}
+
+	var denominations []entity.PosDrawerSessionDenomination
+	for _, count := range req.Denominations {
+		if err := money.ValidateCurrency(count.Denomination); err != nil {
+			return nil, err
+		}
+
+		if money.FromProto(count.Denomination) <= 0 || count.Count < 0 {
+			return nil, errors.New("invalid denomination count")
+		}
+
+		denominations = append(denominations, entity.PosDrawerSessionDenomination{
+			DenominationID: uuid.New(),
+			Denomination:   money.FromProto(count.Denomination),
+			Count:          int(count.Count),
+			Amount:         money.FromProto(count.Denomination).Times(int(count.Count)),
+		})
+	}
+
+	closedSession, err := s.sessionRepo.ClosePosDrawerSession(req.SessionId, denominations, uuid.MustParse(identity.Payload.UserId), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	pbPosDrawerSession := repository.PosDrawerSessionToProto(closedSession)
+	if access.Level == policy.STORE_USER {
+		pbPosDrawerSession.ExpectedCash = money.ToProto(0)
+		pbPosDrawerSession.Variance = money.ToProto(0)
+	}
+
+	return &pb.CloseDrawerSessionResponse{
+		PosDrawerSession: pbPosDrawerSession,
+	}, nil
+}
+
+// ReadAllDrawerSessions lists the sessions with their variances for loss prevention review
+func (s *PosCashDrawerServiceServer) ReadAllDrawerSessions(ctx context.Context, req *pb.ReadAllDrawerSessionsRequest) (*pb.ReadAllDrawerSessionsResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paginationResult, err := s.sessionRepo.ReadAllPosDrawerSessions(pagination, access.RoleName, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	posDrawerSessions := paginationResult.Records.([]entity.PosDrawerSession)
+	pbPosDrawerSessions := make([]*pb.PosDrawerSession, len(posDrawerSessions))
+
+	for i := range posDrawerSessions {
+		pbPosDrawerSessions[i] = repository.PosDrawerSessionToProto(&posDrawerSessions[i])
+	}
+
+	return &pb.ReadAllDrawerSessionsResponse{
+		PosDrawerSessions: pbPosDrawerSessions,
+		Limit:             int32(pagination.Limit),
+		Page:              int32(pagination.Page),
+		MaxPage:           int32(paginationResult.TotalPages),
+		Count:             paginationResult.TotalRecords,
+		NextPageToken:     paginationResult.NextPageToken,
+		CountEstimated:    paginationResult.CountEstimated,
+	}, nil
+}
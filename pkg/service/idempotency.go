@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/idempotency"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const idempotencyPurgeInterval = 1 * time.Hour
+
+// IdempotencyKeys answers a checkout retried with the same Idempotency-Key with the response of the first one.
+// The key is reserved before the checkout starts and completed in the transaction that stores the sale,
+// a key sent again with a different request is rejected.
+type IdempotencyKeys struct {
+	repo     repository.PosIdempotencyKeyRepository
+	settings config.IdempotencySettings
+	logger   *slog.Logger
+}
+
+func NewIdempotencyKeys(repo repository.PosIdempotencyKeyRepository, settings config.IdempotencySettings, logger *slog.Logger) *IdempotencyKeys {
+	return &IdempotencyKeys{
+		repo:     repo,
+		settings: settings,
+		logger:   logger.With(slog.String("component", "idempotency_keys")),
+	}
+}
+
+// Run removes the expired keys until the context is cancelled
+func (k *IdempotencyKeys) Run(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := k.repo.DeleteExpiredPosIdempotencyKeys(time.Now())
+		if err != nil {
+			k.logger.Error("failed to delete expired idempotency keys", slog.String("error", err.Error()))
+		} else if deleted > 0 {
+			k.logger.Info("deleted expired idempotency keys", slog.Int64("count", deleted))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkoutOnce runs checkout with the key reserved for the call, or without a key when the call has none.
+// A call whose key was completed before gets the stored response decoded into replay instead.
+func checkoutOnce[T proto.Message](ctx context.Context, keys *IdempotencyKeys, req proto.Message, replay T, checkout func(key *entity.PosIdempotencyKey) (T, error)) (T, error) {
+	var empty T
+
+	key, stored, err := keys.reserve(ctx, req)
+	if err != nil {
+		return empty, err
+	}
+
+	if stored != nil {
+		if err := proto.Unmarshal(stored.Response, replay); err != nil {
+			return empty, err
+		}
+		keys.logger.InfoContext(ctx, "replaying checkout", slog.String("idempotency_key", stored.IdempotencyKey))
+		return replay, nil
+	}
+
+	resp, err := checkout(key)
+	if err != nil && key != nil {
+		// The failed checkout stored nothing, the till may retry with the same key
+		if releaseErr := keys.repo.ReleasePosIdempotencyKey(key); releaseErr != nil {
+			keys.logger.ErrorContext(ctx, "failed to release idempotency key",
+				slog.String("idempotency_key", key.IdempotencyKey),
+				slog.String("error", releaseErr.Error()))
+		}
+	}
+	return resp, err
+}
+
+// reserve returns the key reserved for the call, or the stored key of a completed call with the same request
+func (k *IdempotencyKeys) reserve(ctx context.Context, req proto.Message) (key, stored *entity.PosIdempotencyKey, err error) {
+	idempotencyKey, err := idempotency.FromIncomingContext(ctx)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if idempotencyKey == "" {
+		return nil, nil, nil
+	}
+
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	userID, err := uuid.Parse(identity.Payload.GetUserId())
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid user id in token")
+	}
+
+	method, _ := grpc.Method(ctx)
+	requestHash, err := hashRequest(method, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	existing, reserved, err := k.repo.ReservePosIdempotencyKey(&entity.PosIdempotencyKey{
+		UserID:         userID,
+		IdempotencyKey: idempotencyKey,
+		Method:         method,
+		RequestHash:    requestHash,
+		Status:         entity.IDEMPOTENCY_STATUS_IN_PROGRESS,
+		ExpiresAt:      now.Add(k.settings.TTL),
+	}, now.Add(-k.settings.LockTimeout))
+	if err != nil {
+		return nil, nil, err
+	}
+	if reserved {
+		return existing, nil, nil
+	}
+
+	if existing.Method != method || existing.RequestHash != requestHash {
+		return nil, nil, status.Error(codes.FailedPrecondition, "idempotency key was already used for a different request")
+	}
+	if existing.Status != entity.IDEMPOTENCY_STATUS_COMPLETED {
+		return nil, nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+	return nil, existing, nil
+}
+
+// completeIdempotencyKey marks the key completed with resp, it is stored together with the sale
+func completeIdempotencyKey(key *entity.PosIdempotencyKey, resp proto.Message) error {
+	response, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	key.Status = entity.IDEMPOTENCY_STATUS_COMPLETED
+	key.Response = response
+	return nil
+}
+
+// hashRequest fingerprints the request without the token, a retry after the token was renewed is the same request
+func hashRequest(method string, req proto.Message) (string, error) {
+	msg := proto.Clone(req).ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"jwt_payload", "jwt_token"} {
+		if field := fields.ByName(name); field != nil {
+			msg.Clear(field)
+		}
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -2,18 +2,17 @@ package service
 
 import (
 	"context"
-	"errors"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
-	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,34 +26,27 @@ type PosInvoiceService interface {
 
 type posInvoiceService struct {
 	pb.UnimplementedPosInvoiceServiceServer
-	invoiceRepo        repository.PosInvoiceRepository
-	CompanyServiceConn *grpc.ClientConn
+	invoiceRepo repository.PosInvoiceRepository
 }
 
-func NewPosInvoiceService(invoiceRepo repository.PosInvoiceRepository, companyServiceConn *grpc.ClientConn) *posInvoiceService {
+func NewPosInvoiceService(invoiceRepo repository.PosInvoiceRepository) *posInvoiceService {
 	return &posInvoiceService{
-		invoiceRepo:        invoiceRepo,
-		CompanyServiceConn: companyServiceConn,
+		invoiceRepo: invoiceRepo,
 	}
 }
 
 func (s *posInvoiceService) CreatePosInvoice(ctx context.Context, req *pb.CreatePosInvoiceRequest) (*pb.CreatePosInvoiceResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant create invoice")
-	}
-
 	req.PosInvoice.InvoiceId = uuid.New().String() // Generate a new UUID for the invoice_id
 
+	if err := money.ValidateCurrency(req.PosInvoice.Amount, req.PosInvoice.Discounts, req.PosInvoice.Taxes); err != nil {
+		return nil, err
+	}
+
 	now := timestamppb.New(time.Now())
 	req.PosInvoice.CreatedAt = now
 	req.PosInvoice.UpdatedAt = now
@@ -64,15 +56,15 @@ func (s *posInvoiceService) CreatePosInvoice(ctx context.Context, req *pb.Create
 		InvoiceID: uuid.MustParse(req.PosInvoice.InvoiceId),
 		ReceiptID: req.PosInvoice.ReceiptId,
 		Date:      req.PosInvoice.Date.AsTime(),
-		Amount:    req.PosInvoice.Amount,
-		Discounts: req.PosInvoice.Discounts,
-		Taxes:     req.PosInvoice.Taxes,
-		BranchID:  uuid.MustParse(req.JwtPayload.BranchId),
-		CompanyID: uuid.MustParse(req.JwtPayload.CompanyId),
+		Amount:    money.FromProto(req.PosInvoice.Amount),
+		Discounts: money.FromProto(req.PosInvoice.Discounts),
+		Taxes:     money.FromProto(req.PosInvoice.Taxes),
+		BranchID:  uuid.MustParse(identity.Payload.BranchId),
+		CompanyID: uuid.MustParse(identity.Payload.CompanyId),
 		CreatedAt: req.PosInvoice.CreatedAt.AsTime(),
-		CreatedBy: uuid.MustParse(req.JwtPayload.UserId),
+		CreatedBy: uuid.MustParse(identity.Payload.UserId),
 		UpdatedAt: req.PosInvoice.UpdatedAt.AsTime(),
-		UpdatedBy: uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedBy: uuid.MustParse(identity.Payload.UserId),
 	}
 
 	err = s.invoiceRepo.CreatePosInvoice(gormInvoice)
@@ -86,26 +78,24 @@ func (s *posInvoiceService) CreatePosInvoice(ctx context.Context, req *pb.Create
 }
 
 func (s *posInvoiceService) ReadAllPosInvoices(ctx context.Context, req *pb.ReadAllPosInvoicesRequest) (*pb.ReadAllPosInvoicesResponse, error) {
-	pagination := dto.Pagination{
-		Limit: int(req.Limit),
-		Page:  int(req.Page),
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read all invoice")
-	}
-
-	paginationResult, err := s.invoiceRepo.ReadAllPosInvoices(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	paginationResult, err := s.invoiceRepo.ReadAllPosInvoices(pagination, access.RoleName, identity.Payload, req.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -118,9 +108,9 @@ func (s *posInvoiceService) ReadAllPosInvoices(ctx context.Context, req *pb.Read
 			InvoiceId: posInvoice.InvoiceID.String(),
 			ReceiptId: posInvoice.ReceiptID,
 			Date:      timestamppb.New(posInvoice.Date),
-			Amount:    posInvoice.Amount,
-			Discounts: posInvoice.Discounts,
-			Taxes:     posInvoice.Taxes,
+			Amount:    money.ToProto(posInvoice.Amount),
+			Discounts: money.ToProto(posInvoice.Discounts),
+			Taxes:     money.ToProto(posInvoice.Taxes),
 			BranchId:  posInvoice.BranchID.String(),
 			CompanyId: posInvoice.CompanyID.String(),
 			CreatedAt: timestamppb.New(posInvoice.CreatedAt),
@@ -131,54 +121,29 @@ func (s *posInvoiceService) ReadAllPosInvoices(ctx context.Context, req *pb.Read
 	}
 
 	return &pb.ReadAllPosInvoicesResponse{
-		PosInvoices: pbPosInvoices,
-		Limit:       int32(pagination.Limit),
-		Page:        int32(pagination.Page),
-		MaxPage:     int32(paginationResult.TotalPages),
-		Count:       paginationResult.TotalRecords,
+		PosInvoices:    pbPosInvoices,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
+		NextPageToken:  paginationResult.NextPageToken,
+		CountEstimated: paginationResult.CountEstimated,
 	}, nil
 }
 
 func (s *posInvoiceService) ReadPosInvoice(ctx context.Context, req *pb.ReadPosInvoiceRequest) (*pb.ReadPosInvoiceResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read invoice")
-	}
-
 	posInvoice, err := s.invoiceRepo.ReadPosInvoice(req.InvoiceId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posInvoice.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only retrieve invoice data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posInvoice.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only retrieve invoice data within their branch")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posInvoice.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("store users can only retrieve invoice data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posInvoice.CompanyId, BranchID: posInvoice.BranchId}); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPosInvoiceResponse{
@@ -187,19 +152,14 @@ func (s *posInvoiceService) ReadPosInvoice(ctx context.Context, req *pb.ReadPosI
 }
 
 func (s *posInvoiceService) UpdatePosInvoice(ctx context.Context, req *pb.UpdatePosInvoiceRequest) (*pb.UpdatePosInvoiceResponse, error) {
-	// Get the role name from the role ID in the JWT payload
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant update invoice data")
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the invoice to be updated
@@ -208,12 +168,12 @@ func (s *posInvoiceService) UpdatePosInvoice(ctx context.Context, req *pb.Update
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	if err := access.Authorize(policy.Resource{CompanyID: posInvoice.CompanyId, BranchID: posInvoice.BranchId}); err != nil {
+		return nil, err
+	}
 
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posInvoice.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only update invoice data within their branch")
-		}
+	if err := money.ValidateCurrency(req.PosInvoice.Amount, req.PosInvoice.Discounts, req.PosInvoice.Taxes); err != nil {
+		return nil, err
 	}
 
 	now := timestamppb.New(time.Now())
@@ -223,15 +183,15 @@ func (s *posInvoiceService) UpdatePosInvoice(ctx context.Context, req *pb.Update
 		InvoiceID: uuid.MustParse(posInvoice.InvoiceId),
 		ReceiptID: req.PosInvoice.ReceiptId,
 		Date:      posInvoice.Date.AsTime(),
-		Amount:    req.PosInvoice.Amount,
-		Discounts: req.PosInvoice.Discounts,
-		Taxes:     req.PosInvoice.Taxes,
+		Amount:    money.FromProto(req.PosInvoice.Amount),
+		Discounts: money.FromProto(req.PosInvoice.Discounts),
+		Taxes:     money.FromProto(req.PosInvoice.Taxes),
 		BranchID:  uuid.MustParse(posInvoice.BranchId),
 		CompanyID: uuid.MustParse(posInvoice.CompanyId),
 		CreatedAt: posInvoice.CreatedAt.AsTime(),
 		CreatedBy: uuid.MustParse(posInvoice.CreatedBy),
 		UpdatedAt: req.PosInvoice.UpdatedAt.AsTime(),
-		UpdatedBy: uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedBy: uuid.MustParse(identity.Payload.UserId),
 	}
 
 	// Update the invoice
@@ -246,32 +206,19 @@ func (s *posInvoiceService) UpdatePosInvoice(ctx context.Context, req *pb.Update
 }
 
 func (s *posInvoiceService) DeletePosInvoice(ctx context.Context, req *pb.DeletePosInvoiceRequest) (*pb.DeletePosInvoiceResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant update invoice data")
-	}
-
 	// Get the invoice to be updated
 	posInvoice, err := s.invoiceRepo.ReadPosInvoice(req.InvoiceId)
 	if err != nil {
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posInvoice.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only delete invoice data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posInvoice.CompanyId, BranchID: posInvoice.BranchId}); err != nil {
+		return nil, err
 	}
 
 	// Delete the invoice
@@ -2,18 +2,17 @@ package service
 
 import (
 	"context"
-	"errors"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
-	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,34 +26,22 @@ type PosOnlinePaymentService interface {
 
 type posOnlinePaymentService struct {
 	pb.UnimplementedPosOnlinePaymentServiceServer
-	onlinePaymentRepo  repository.PosOnlinePaymentRepository
-	CompanyServiceConn *grpc.ClientConn
+	onlinePaymentRepo repository.PosOnlinePaymentRepository
 }
 
-func NewPosOnlinePaymentService(onlinePaymentRepo repository.PosOnlinePaymentRepository, companyServiceConn *grpc.ClientConn) *posOnlinePaymentService {
+func NewPosOnlinePaymentService(onlinePaymentRepo repository.PosOnlinePaymentRepository) *posOnlinePaymentService {
 	return &posOnlinePaymentService{
-		onlinePaymentRepo:  onlinePaymentRepo,
-		CompanyServiceConn: companyServiceConn,
+		onlinePaymentRepo: onlinePaymentRepo,
 	}
 }
 
 func (s *posOnlinePaymentService) CreatePosOnlinePayment(ctx context.Context, req *pb.CreatePosOnlinePaymentRequest) (*pb.CreatePosOnlinePaymentResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	req.PosOnlinePayment.PaymentId = uuid.New().String() // Generate a new UUID for the payment_id
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
-	if err != nil {
+	if err := money.ValidateCurrency(req.PosOnlinePayment.Amount); err != nil {
 		return nil, err
 	}
 
-	if !utils.IsStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant create new online payment")
-	}
-
-	req.PosOnlinePayment.PaymentId = uuid.New().String() // Generate a new UUID for the payment_id
-
 	now := timestamppb.New(time.Now())
 	req.PosOnlinePayment.CreatedAt = now
 	req.PosOnlinePayment.UpdatedAt = now
@@ -66,7 +53,7 @@ func (s *posOnlinePaymentService) CreatePosOnlinePayment(ctx context.Context, re
 		EmployeeID:    uuid.MustParse(req.PosOnlinePayment.EmployeeId),
 		PaymentDate:   req.PosOnlinePayment.PaymentDate.AsTime(),
 		ReceiptID:     req.PosOnlinePayment.ReceiptId,
-		Amount:        req.PosOnlinePayment.Amount,
+		Amount:        money.FromProto(req.PosOnlinePayment.Amount),
 		PaymentMethod: uuid.MustParse(req.PosOnlinePayment.PaymentMethod),
 		RoleID:        uuid.MustParse(req.PosOnlinePayment.RoleId),
 		BranchID:      uuid.MustParse(req.PosOnlinePayment.BranchId),
@@ -77,7 +64,7 @@ func (s *posOnlinePaymentService) CreatePosOnlinePayment(ctx context.Context, re
 		UpdatedBy:     uuid.MustParse(req.PosOnlinePayment.UpdatedBy),
 	}
 
-	err = s.onlinePaymentRepo.CreatePosOnlinePayment(gormOnlinePayment)
+	err := s.onlinePaymentRepo.CreatePosOnlinePayment(gormOnlinePayment)
 	if err != nil {
 		return nil, err
 	}
@@ -88,45 +75,18 @@ func (s *posOnlinePaymentService) CreatePosOnlinePayment(ctx context.Context, re
 }
 
 func (s *posOnlinePaymentService) ReadPosOnlinePayment(ctx context.Context, req *pb.ReadPosOnlinePaymentRequest) (*pb.ReadPosOnlinePaymentResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read online payment")
-	}
-
 	posOnlinePayment, err := s.onlinePaymentRepo.ReadPosOnlinePayment(req.PaymentId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posOnlinePayment.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only retrieve online payment data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posOnlinePayment.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only retrieve online payment data within their branch")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posOnlinePayment.StoreId, req.JwtPayload.StoreId) {
-			return nil, errors.New("store users can only retrieve online payment data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posOnlinePayment.CompanyId, BranchID: posOnlinePayment.BranchId, StoreID: posOnlinePayment.StoreId}); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPosOnlinePaymentResponse{
@@ -135,33 +95,23 @@ func (s *posOnlinePaymentService) ReadPosOnlinePayment(ctx context.Context, req
 }
 
 func (s *posOnlinePaymentService) UpdatePosOnlinePayment(ctx context.Context, req *pb.UpdatePosOnlinePaymentRequest) (*pb.UpdatePosOnlinePaymentResponse, error) {
-	// Get the role name from the role ID in the JWT payload
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant update online payment data")
-	}
-
 	// Get the online payment to be updated
 	posOnlinePayment, err := s.onlinePaymentRepo.ReadPosOnlinePayment(req.PosOnlinePayment.PaymentId)
 	if err != nil {
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	if err := access.Authorize(policy.Resource{CompanyID: posOnlinePayment.CompanyId, BranchID: posOnlinePayment.BranchId, StoreID: posOnlinePayment.StoreId}); err != nil {
+		return nil, err
+	}
 
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posOnlinePayment.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only update online payment data within their branch")
-		}
+	if err := money.ValidateCurrency(req.PosOnlinePayment.Amount); err != nil {
+		return nil, err
 	}
 
 	now := timestamppb.New(time.Now())
@@ -173,7 +123,7 @@ func (s *posOnlinePaymentService) UpdatePosOnlinePayment(ctx context.Context, re
 		EmployeeID:    uuid.MustParse(req.PosOnlinePayment.EmployeeId),
 		PaymentDate:   req.PosOnlinePayment.PaymentDate.AsTime(),
 		ReceiptID:     req.PosOnlinePayment.ReceiptId,
-		Amount:        req.PosOnlinePayment.Amount,
+		Amount:        money.FromProto(req.PosOnlinePayment.Amount),
 		PaymentMethod: uuid.MustParse(req.PosOnlinePayment.PaymentMethod),
 		RoleID:        uuid.MustParse(req.PosOnlinePayment.RoleId),
 		BranchID:      uuid.MustParse(req.PosOnlinePayment.BranchId),
@@ -196,32 +146,19 @@ func (s *posOnlinePaymentService) UpdatePosOnlinePayment(ctx context.Context, re
 }
 
 func (s *posOnlinePaymentService) DeletePosOnlinePayment(ctx context.Context, req *pb.DeletePosOnlinePaymentRequest) (*pb.DeletePosOnlinePaymentResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant delete online payment data")
-	}
-
 	// Get the online payment to be updated
 	posOnlinePayment, err := s.onlinePaymentRepo.ReadPosOnlinePayment(req.PaymentId)
 	if err != nil {
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posOnlinePayment.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only delete online payment data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posOnlinePayment.CompanyId, BranchID: posOnlinePayment.BranchId, StoreID: posOnlinePayment.StoreId}); err != nil {
+		return nil, err
 	}
 
 	// Delete the online payment
@@ -236,26 +173,24 @@ func (s *posOnlinePaymentService) DeletePosOnlinePayment(ctx context.Context, re
 }
 
 func (s *posOnlinePaymentService) ReadAllPosOnlinePayments(ctx context.Context, req *pb.ReadAllPosOnlinePaymentsRequest) (*pb.ReadAllPosOnlinePaymentsResponse, error) {
-	pagination := dto.Pagination{
-		Limit: int(req.Limit),
-		Page:  int(req.Page),
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read all online payment")
-	}
-
-	paginationResult, err := s.onlinePaymentRepo.ReadAllPosOnlinePayments(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	paginationResult, err := s.onlinePaymentRepo.ReadAllPosOnlinePayments(pagination, access.RoleName, identity.Payload, req.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +205,7 @@ func (s *posOnlinePaymentService) ReadAllPosOnlinePayments(ctx context.Context,
 			EmployeeId:    posOnlinePayment.EmployeeID.String(),
 			PaymentDate:   timestamppb.New(posOnlinePayment.PaymentDate),
 			ReceiptId:     posOnlinePayment.ReceiptID,
-			Amount:        posOnlinePayment.Amount,
+			Amount:        money.ToProto(posOnlinePayment.Amount),
 			PaymentMethod: posOnlinePayment.PaymentMethod.String(),
 			RoleId:        posOnlinePayment.RoleID.String(),
 			BranchId:      posOnlinePayment.BranchID.String(),
@@ -289,5 +224,7 @@ func (s *posOnlinePaymentService) ReadAllPosOnlinePayments(ctx context.Context,
 		Page:              int32(pagination.Page),
 		MaxPage:           int32(paginationResult.TotalPages),
 		Count:             paginationResult.TotalRecords,
+		NextPageToken:     paginationResult.NextPageToken,
+		CountEstimated:    paginationResult.CountEstimated,
 	}, nil
 }
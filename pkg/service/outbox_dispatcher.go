@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/tracing"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/google/uuid"
+	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Outbox events and the queues they are routed to.
+// Stock is moved by the synchronous inventory calls of checkout and returns, so no inventory event is published.
+const (
+	DIGITAL_RECEIPT_EVENT = "sales.digital_receipt"
+	DIGITAL_RECEIPT_QUEUE = "email_queue"
+)
+
+const (
+	outboxPollInterval   = 2 * time.Second
+	outboxBatchSize      = 50
+	outboxLease          = 1 * time.Minute
+	outboxConfirmTimeout = 5 * time.Second
+	outboxMaxAttempts    = 10
+	outboxBaseBackoff    = 5 * time.Second
+	outboxMaxBackoff     = 10 * time.Minute
+	deadLetterSuffix     = ".dead_letter"
+)
+
+// RabbitMQConnector hands out a live RabbitMQ connection, dialing again when the previous one was lost
+type RabbitMQConnector interface {
+	Connection() (*amqp.Connection, error)
+}
+
+// OutboxDispatcher drains the pos_outbox_messages table into RabbitMQ.
+// Messages are retried with exponential backoff and moved to a dead letter queue after outboxMaxAttempts.
+type OutboxDispatcher struct {
+	outboxRepo repository.PosOutboxRepository
+	rabbitMQ   RabbitMQConnector
+	logger     *slog.Logger
+}
+
+func NewOutboxDispatcher(outboxRepo repository.PosOutboxRepository, rabbitMQ RabbitMQConnector, logger *slog.Logger) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		outboxRepo: outboxRepo,
+		rabbitMQ:   rabbitMQ,
+		logger:     logger.With(slog.String("component", "outbox_dispatcher")),
+	}
+}
+
+// Run polls the outbox until the context is cancelled
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.dispatch(); err != nil {
+			d.logger.Error("failed to dispatch outbox messages", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *OutboxDispatcher) dispatch() error {
+	messages, err := d.outboxRepo.ClaimPosOutboxMessages(outboxBatchSize, outboxLease)
+	if err != nil {
+		return err
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ch, err := d.openConfirmChannel()
+	if err != nil {
+		// Broker is unreachable, keep the messages in the outbox for the next attempt
+		for i := range messages {
+			d.retry(nil, &messages[i], err)
+		}
+		return err
+	}
+	defer ch.Close()
+
+	for i := range messages {
+		message := &messages[i]
+		err := d.publish(ch, message, message.RoutingKey)
+		if err != nil {
+			d.retry(ch, message, err)
+			continue
+		}
+
+		if err := d.outboxRepo.MarkPosOutboxMessagePublished(message.MessageID.String()); err != nil {
+			d.logger.Error("failed to mark outbox message as published", slog.String("message_id", message.MessageID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// confirmChannel is a channel in confirm mode with the listeners for its acks and returned messages
+type confirmChannel struct {
+	*amqp.Channel
+	confirms <-chan amqp.Confirmation
+	returns  <-chan amqp.Return
+	// published is the delivery tag of the last message sent on the channel
+	published uint64
+}
+
+func (d *OutboxDispatcher) openConfirmChannel() (*confirmChannel, error) {
+	conn, err := d.rabbitMQ.Connection()
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	// Enable publisher confirms so a message is only marked published once the broker owns it
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, err
+	}
+
+	return &confirmChannel{
+		Channel:  ch,
+		confirms: ch.NotifyPublish(make(chan amqp.Confirmation, 1)),
+		// Messages are published one at a time, a single return can be pending
+		returns: ch.NotifyReturn(make(chan amqp.Return, 1)),
+	}, nil
+}
+
+// publish sends a message in a producer span that continues the trace of the call that queued it,
+// the trace context travels on in the AMQP headers,
+// and a message that reaches no queue counts as failed so it stays in the outbox
+func (d *OutboxDispatcher) publish(ch *confirmChannel, message *entity.PosOutboxMessage, routingKey string) error {
+	ctx := tracing.UnmarshalContext(context.Background(), message.TraceContext)
+	ctx, span := tracing.Start(ctx, routingKey+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitmq,
+			semconv.MessagingDestinationName(routingKey),
+			semconv.MessagingMessageID(message.MessageID.String()),
+			attribute.String("outbox.event_type", message.EventType),
+			attribute.Int("outbox.attempts", message.Attempts),
+		),
+	)
+
+	err := utils.PublishWithConfirm(ch.Channel, ch.confirms, ch.returns, &ch.published, routingKey, message.MessageID.String(), []byte(message.Payload), tracing.AMQPHeaders(ctx), outboxConfirmTimeout)
+	tracing.End(span, err)
+	return err
+}
+
+// retry schedules the next attempt of a message, or dead-letters it once it ran out of attempts
+func (d *OutboxDispatcher) retry(ch *confirmChannel, message *entity.PosOutboxMessage, cause error) {
+	attempts := message.Attempts + 1
+	messageID := message.MessageID.String()
+
+	if attempts < outboxMaxAttempts {
+		nextAttemptAt := time.Now().Add(outboxBackoff(attempts))
+		if err := d.outboxRepo.MarkPosOutboxMessageFailed(messageID, attempts, cause.Error(), nextAttemptAt); err != nil {
+			d.logger.Error("failed to reschedule outbox message", slog.String("message_id", messageID), slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	// The message stays in the outbox with DEAD status, the dead letter queue copy is best effort
+	if ch != nil {
+		deadLetterQueue := message.RoutingKey + deadLetterSuffix
+		if _, err := ch.QueueDeclare(deadLetterQueue, true, false, false, false, nil); err == nil {
+			if err := d.publish(ch, message, deadLetterQueue); err != nil {
+				d.logger.Error("failed to dead-letter outbox message", slog.String("message_id", messageID), slog.String("error", err.Error()))
+			}
+		}
+	}
+
+	if err := d.outboxRepo.MarkPosOutboxMessageDead(messageID, attempts, cause.Error()); err != nil {
+		d.logger.Error("failed to mark outbox message as dead", slog.String("message_id", messageID), slog.String("error", err.Error()))
+	}
+}
+
+// outboxBackoff doubles the wait after every failed attempt up to outboxMaxBackoff
+func outboxBackoff(attempts int) time.Duration {
+	backoff := outboxBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// newOutboxMessage serializes an event so it can be written in the same transaction as the data it describes
+func newOutboxMessage(ctx context.Context, aggregateID string, eventType string, routingKey string, payload interface{}, now time.Time) (*entity.PosOutboxMessage, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+
+	return &entity.PosOutboxMessage{
+		MessageID:     uuid.New(),
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		RoutingKey:    routingKey,
+		Payload:       string(body),
+		TraceContext:  tracing.MarshalContext(ctx),
+		Status:        entity.OUTBOX_STATUS_PENDING,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
@@ -2,19 +2,17 @@ package service
 
 import (
 	"context"
-	"errors"
-	"os"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
-	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,32 +26,21 @@ type PosPaymentMethodService interface {
 
 type posPaymentMethodService struct {
 	pb.UnimplementedPosPaymentMethodServiceServer
-	repo               repository.PosPaymentMethodRepository
-	CompanyServiceConn *grpc.ClientConn
+	repo repository.PosPaymentMethodRepository
 }
 
-func NewPosPaymentMethodService(repo repository.PosPaymentMethodRepository, companyServiceConn *grpc.ClientConn) *posPaymentMethodService {
+func NewPosPaymentMethodService(repo repository.PosPaymentMethodRepository) *posPaymentMethodService {
 	return &posPaymentMethodService{
-		repo:               repo,
-		CompanyServiceConn: companyServiceConn,
+		repo: repo,
 	}
 }
 
 func (s *posPaymentMethodService) CreatePosPaymentMethod(ctx context.Context, req *pb.CreatePosPaymentMethodRequest) (*pb.CreatePosPaymentMethodResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to create new payment method")
-	}
-
 	req.PosPaymentMethod.PaymentMethodId = uuid.New().String() // Generate a new UUID for the payment_method_id
 
 	now := timestamppb.New(time.Now())
@@ -64,11 +51,11 @@ func (s *posPaymentMethodService) CreatePosPaymentMethod(ctx context.Context, re
 	gormPaymentMethod := &entity.PosPaymentMethod{
 		PaymentMethodID: uuid.MustParse(req.PosPaymentMethod.PaymentMethodId), // auto
 		MethodName:      req.PosPaymentMethod.MethodName,
-		CompanyID:       uuid.MustParse(req.JwtPayload.CompanyId), // auto
-		CreatedAt:       req.PosPaymentMethod.CreatedAt.AsTime(),  // auto
-		CreatedBy:       uuid.MustParse(req.JwtPayload.UserId),    // auto
-		UpdatedAt:       req.PosPaymentMethod.UpdatedAt.AsTime(),  // auto
-		UpdatedBy:       uuid.MustParse(req.JwtPayload.UserId),    // auto
+		CompanyID:       uuid.MustParse(identity.Payload.CompanyId), // auto
+		CreatedAt:       req.PosPaymentMethod.CreatedAt.AsTime(),    // auto
+		CreatedBy:       uuid.MustParse(identity.Payload.UserId),    // auto
+		UpdatedAt:       req.PosPaymentMethod.UpdatedAt.AsTime(),    // auto
+		UpdatedBy:       uuid.MustParse(identity.Payload.UserId),    // auto
 	}
 
 	err = s.repo.CreatePosPaymentMethod(gormPaymentMethod)
@@ -82,45 +69,18 @@ func (s *posPaymentMethodService) CreatePosPaymentMethod(ctx context.Context, re
 }
 
 func (s *posPaymentMethodService) ReadPosPaymentMethod(ctx context.Context, req *pb.ReadPosPaymentMethodRequest) (*pb.ReadPosPaymentMethodResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to read payment method")
-	}
-
 	posPaymentMethod, err := s.repo.ReadPosPaymentMethod(req.PaymentMethodId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posPaymentMethod.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only retrieve payment method data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posPaymentMethod.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("branch users can only retrieve payment method data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posPaymentMethod.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("store users can only retrieve payment method data within their company")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posPaymentMethod.CompanyId}); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPosPaymentMethodResponse{
@@ -129,18 +89,14 @@ func (s *posPaymentMethodService) ReadPosPaymentMethod(ctx context.Context, req
 }
 
 func (s *posPaymentMethodService) UpdatePosPaymentMethod(ctx context.Context, req *pb.UpdatePosPaymentMethodRequest) (*pb.UpdatePosPaymentMethodResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to update payment method")
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the payment method to be updated
@@ -149,17 +105,13 @@ func (s *posPaymentMethodService) UpdatePosPaymentMethod(ctx context.Context, re
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posPaymentMethod.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only update payment method data within their company")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posPaymentMethod.CompanyId}); err != nil {
+		return nil, err
 	}
 
 	now := timestamppb.New(time.Now())
 	req.PosPaymentMethod.UpdatedAt = now
-	req.PosPaymentMethod.UpdatedBy = req.JwtPayload.UserId
+	req.PosPaymentMethod.UpdatedBy = identity.Payload.UserId
 
 	// Convert pb.PosPaymentMethod to entity.PosPaymentMethod
 	gormPaymentMethod := &entity.PosPaymentMethod{
@@ -184,32 +136,19 @@ func (s *posPaymentMethodService) UpdatePosPaymentMethod(ctx context.Context, re
 }
 
 func (s *posPaymentMethodService) DeletePosPaymentMethod(ctx context.Context, req *pb.DeletePosPaymentMethodRequest) (*pb.DeletePosPaymentMethodResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to delete payment method")
-	}
-
 	// Get the payment method to be deleted
 	posPaymentMethod, err := s.repo.ReadPosPaymentMethod(req.PaymentMethodId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posPaymentMethod.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only delete payment method data within their company")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posPaymentMethod.CompanyId}); err != nil {
+		return nil, err
 	}
 
 	// Delete the payment method
@@ -224,26 +163,24 @@ func (s *posPaymentMethodService) DeletePosPaymentMethod(ctx context.Context, re
 }
 
 func (s *posPaymentMethodService) ReadAllPosPaymentMethods(ctx context.Context, req *pb.ReadAllPosPaymentMethodsRequest) (*pb.ReadAllPosPaymentMethodsResponse, error) {
-	pagination := dto.Pagination{
-		Limit: int(req.Limit),
-		Page:  int(req.Page),
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to read all payment method")
-	}
-
-	paginationResult, err := s.repo.ReadAllPosPaymentMethods(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	paginationResult, err := s.repo.ReadAllPosPaymentMethods(pagination, access.RoleName, identity.Payload)
 	if err != nil {
 		return nil, err
 	}
@@ -269,5 +206,7 @@ func (s *posPaymentMethodService) ReadAllPosPaymentMethods(ctx context.Context,
 		Page:              int32(pagination.Page),
 		MaxPage:           int32(paginationResult.TotalPages),
 		Count:             paginationResult.TotalRecords,
+		NextPageToken:     paginationResult.NextPageToken,
+		CountEstimated:    paginationResult.CountEstimated,
 	}, nil
 }
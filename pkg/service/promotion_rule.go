@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosPromotionRuleService interface {
+	CreatePosPromotionRule(ctx context.Context, req *pb.CreatePosPromotionRuleRequest) (*pb.CreatePosPromotionRuleResponse, error)
+	ReadPosPromotionRule(ctx context.Context, req *pb.ReadPosPromotionRuleRequest) (*pb.ReadPosPromotionRuleResponse, error)
+	UpdatePosPromotionRule(ctx context.Context, req *pb.UpdatePosPromotionRuleRequest) (*pb.UpdatePosPromotionRuleResponse, error)
+	DeletePosPromotionRule(ctx context.Context, req *pb.DeletePosPromotionRuleRequest) (*pb.DeletePosPromotionRuleResponse, error)
+	ReadAllPosPromotionRules(ctx context.Context, req *pb.ReadAllPosPromotionRulesRequest) (*pb.ReadAllPosPromotionRulesResponse, error)
+}
+
+type posPromotionRuleService struct {
+	pb.UnimplementedPosPromotionRuleServiceServer
+	repo repository.PosPromotionRuleRepository
+}
+
+func NewPosPromotionRuleService(repo repository.PosPromotionRuleRepository) *posPromotionRuleService {
+	return &posPromotionRuleService{
+		repo: repo,
+	}
+}
+
+func (s *posPromotionRuleService) CreatePosPromotionRule(ctx context.Context, req *pb.CreatePosPromotionRuleRequest) (*pb.CreatePosPromotionRuleResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePosPromotionRule(req.PosPromotionRule); err != nil {
+		return nil, err
+	}
+
+	req.PosPromotionRule.PromotionRuleId = uuid.New().String() // Generate a new UUID for the promotion_rule_id
+	req.PosPromotionRule.CompanyId = identity.Payload.CompanyId
+
+	now := timestamppb.New(time.Now())
+	req.PosPromotionRule.CreatedAt = now
+	req.PosPromotionRule.CreatedBy = identity.Payload.UserId
+	req.PosPromotionRule.UpdatedAt = now
+	req.PosPromotionRule.UpdatedBy = identity.Payload.UserId
+
+	// Convert pb.PosPromotionRule to entity.PosPromotionRule
+	gormPromotionRule := &entity.PosPromotionRule{
+		PromotionRuleID: uuid.MustParse(req.PosPromotionRule.PromotionRuleId), // auto
+		Name:            req.PosPromotionRule.Name,
+		RuleType:        req.PosPromotionRule.RuleType,
+		ProductIDs:      strings.Join(req.PosPromotionRule.ProductIds, ","),
+		DiscountRate:    req.PosPromotionRule.DiscountRate,
+		DiscountAmount:  money.FromProto(req.PosPromotionRule.DiscountAmount),
+		BuyQuantity:     int(req.PosPromotionRule.BuyQuantity),
+		GetQuantity:     int(req.PosPromotionRule.GetQuantity),
+		BundlePrice:     money.FromProto(req.PosPromotionRule.BundlePrice),
+		MinSubtotal:     money.FromProto(req.PosPromotionRule.MinSubtotal),
+		StartDate:       req.PosPromotionRule.StartDate.AsTime(),
+		EndDate:         req.PosPromotionRule.EndDate.AsTime(),
+		Active:          req.PosPromotionRule.Active,
+		CompanyID:       uuid.MustParse(identity.Payload.CompanyId), // auto
+		CreatedAt:       req.PosPromotionRule.CreatedAt.AsTime(),    // auto
+		CreatedBy:       uuid.MustParse(identity.Payload.UserId),    // auto
+		UpdatedAt:       req.PosPromotionRule.UpdatedAt.AsTime(),    // auto
+		UpdatedBy:       uuid.MustParse(identity.Payload.UserId),    // auto
+	}
+
+	if req.PosPromotionRule.StoreId != "" {
+		gormPromotionRule.StoreID = utils.ParseUUID(req.PosPromotionRule.StoreId)
+	}
+
+	if req.PosPromotionRule.BranchId != "" {
+		gormPromotionRule.BranchID = utils.ParseUUID(req.PosPromotionRule.BranchId)
+	}
+
+	err = s.repo.CreatePosPromotionRule(gormPromotionRule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosPromotionRuleResponse{
+		PosPromotionRule: req.PosPromotionRule,
+	}, nil
+}
+
+func (s *posPromotionRuleService) ReadPosPromotionRule(ctx context.Context, req *pb.ReadPosPromotionRuleRequest) (*pb.ReadPosPromotionRuleResponse, error) {
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	posPromotionRule, err := s.repo.ReadPosPromotionRule(req.PromotionRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posPromotionRule.CompanyId}); err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadPosPromotionRuleResponse{
+		PosPromotionRule: posPromotionRule,
+	}, nil
+}
+
+func (s *posPromotionRuleService) UpdatePosPromotionRule(ctx context.Context, req *pb.UpdatePosPromotionRuleRequest) (*pb.UpdatePosPromotionRuleResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePosPromotionRule(req.PosPromotionRule); err != nil {
+		return nil, err
+	}
+
+	// Get the promotion rule to be updated
+	posPromotionRule, err := s.repo.ReadPosPromotionRule(req.PosPromotionRule.PromotionRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posPromotionRule.CompanyId}); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.New(time.Now())
+	req.PosPromotionRule.UpdatedAt = now
+	req.PosPromotionRule.UpdatedBy = identity.Payload.UserId
+
+	// Convert pb.PosPromotionRule to entity.PosPromotionRule
+	gormPromotionRule := &entity.PosPromotionRule{
+		PromotionRuleID: uuid.MustParse(posPromotionRule.PromotionRuleId),
+		Name:            req.PosPromotionRule.Name,
+		RuleType:        req.PosPromotionRule.RuleType,
+		ProductIDs:      strings.Join(req.PosPromotionRule.ProductIds, ","),
+		DiscountRate:    req.PosPromotionRule.DiscountRate,
+		DiscountAmount:  money.FromProto(req.PosPromotionRule.DiscountAmount),
+		BuyQuantity:     int(req.PosPromotionRule.BuyQuantity),
+		GetQuantity:     int(req.PosPromotionRule.GetQuantity),
+		BundlePrice:     money.FromProto(req.PosPromotionRule.BundlePrice),
+		MinSubtotal:     money.FromProto(req.PosPromotionRule.MinSubtotal),
+		StartDate:       req.PosPromotionRule.StartDate.AsTime(),
+		EndDate:         req.PosPromotionRule.EndDate.AsTime(),
+		Active:          req.PosPromotionRule.Active,
+		CompanyID:       uuid.MustParse(posPromotionRule.CompanyId),
+		CreatedAt:       posPromotionRule.CreatedAt.AsTime(),
+		CreatedBy:       uuid.MustParse(posPromotionRule.CreatedBy),
+		UpdatedAt:       req.PosPromotionRule.UpdatedAt.AsTime(),
+		UpdatedBy:       uuid.MustParse(req.PosPromotionRule.UpdatedBy),
+	}
+
+	if req.PosPromotionRule.StoreId != "" {
+		gormPromotionRule.StoreID = utils.ParseUUID(req.PosPromotionRule.StoreId)
+	}
+
+	if req.PosPromotionRule.BranchId != "" {
+		gormPromotionRule.BranchID = utils.ParseUUID(req.PosPromotionRule.BranchId)
+	}
+
+	// Update the promotion rule
+	posPromotionRule, err = s.repo.UpdatePosPromotionRule(gormPromotionRule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosPromotionRuleResponse{
+		PosPromotionRule: posPromotionRule,
+	}, nil
+}
+
+func (s *posPromotionRuleService) DeletePosPromotionRule(ctx context.Context, req *pb.DeletePosPromotionRuleRequest) (*pb.DeletePosPromotionRuleResponse, error) {
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the promotion rule to be deleted
+	posPromotionRule, err := s.repo.ReadPosPromotionRule(req.PromotionRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posPromotionRule.CompanyId}); err != nil {
+		return nil, err
+	}
+
+	// Delete the promotion rule
+	err = s.repo.DeletePosPromotionRule(req.PromotionRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePosPromotionRuleResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *posPromotionRuleService) ReadAllPosPromotionRules(ctx context.Context, req *pb.ReadAllPosPromotionRulesRequest) (*pb.ReadAllPosPromotionRulesResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paginationResult, err := s.repo.ReadAllPosPromotionRules(pagination, access.RoleName, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	posPromotionRules := paginationResult.Records.([]entity.PosPromotionRule)
+	pbPosPromotionRules := make([]*pb.PosPromotionRule, len(posPromotionRules))
+
+	for i := range posPromotionRules {
+		pbPosPromotionRules[i] = repository.PosPromotionRuleToProto(&posPromotionRules[i])
+	}
+
+	return &pb.ReadAllPosPromotionRulesResponse{
+		PosPromotionRules: pbPosPromotionRules,
+		Limit:             int32(pagination.Limit),
+		Page:              int32(pagination.Page),
+		MaxPage:           int32(paginationResult.TotalPages),
+		Count:             paginationResult.TotalRecords,
+		NextPageToken:     paginationResult.NextPageToken,
+		CountEstimated:    paginationResult.CountEstimated,
+	}, nil
+}
+
+func validatePosPromotionRule(posPromotionRule *pb.PosPromotionRule) error {
+	if posPromotionRule == nil || posPromotionRule.Name == "" {
+		return errors.New("promotion rule name is required")
+	}
+
+	if posPromotionRule.StartDate == nil || posPromotionRule.EndDate == nil {
+		return errors.New("promotion rule start date and end date are required")
+	}
+
+	if !posPromotionRule.EndDate.AsTime().After(posPromotionRule.StartDate.AsTime()) {
+		return errors.New("promotion rule end date must be after its start date")
+	}
+
+	for _, productID := range posPromotionRule.ProductIds {
+		if _, err := uuid.Parse(productID); err != nil {
+			return errors.New("invalid product id")
+		}
+	}
+
+	if posPromotionRule.StoreId != "" {
+		if _, err := uuid.Parse(posPromotionRule.StoreId); err != nil {
+			return errors.New("invalid store id")
+		}
+	}
+
+	if posPromotionRule.BranchId != "" {
+		if _, err := uuid.Parse(posPromotionRule.BranchId); err != nil {
+			return errors.New("invalid branch id")
+		}
+	}
+
+	if err := money.ValidateCurrency(posPromotionRule.DiscountAmount, posPromotionRule.BundlePrice, posPromotionRule.MinSubtotal); err != nil {
+		return err
+	}
+
+	switch posPromotionRule.RuleType {
+	case entity.PROMOTION_RULE_PERCENTAGE:
+		if len(posPromotionRule.ProductIds) == 0 {
+			return errors.New("percentage promotion needs at least one product")
+		}
+		if posPromotionRule.DiscountRate <= 0 || posPromotionRule.DiscountRate > 1 {
+			return errors.New("discount rate must be a fraction between 0 and 1")
+		}
+	case entity.PROMOTION_RULE_FIXED_AMOUNT:
+		if len(posPromotionRule.ProductIds) == 0 {
+			return errors.New("fixed amount promotion needs at least one product")
+		}
+		if money.FromProto(posPromotionRule.DiscountAmount) <= 0 {
+			return errors.New("discount amount must be greater than zero")
+		}
+	case entity.PROMOTION_RULE_BUY_X_GET_Y:
+		if len(posPromotionRule.ProductIds) == 0 {
+			return errors.New("buy x get y promotion needs at least one product")
+		}
+		if posPromotionRule.BuyQuantity <= 0 || posPromotionRule.GetQuantity <= 0 {
+			return errors.New("buy quantity and get quantity must be greater than zero")
+		}
+	case entity.PROMOTION_RULE_BUNDLE:
+		if len(posPromotionRule.ProductIds) < 2 {
+			return errors.New("bundle promotion needs at least two products")
+		}
+		if money.FromProto(posPromotionRule.BundlePrice) <= 0 {
+			return errors.New("bundle price must be greater than zero")
+		}
+	case entity.PROMOTION_RULE_RECEIPT_THRESHOLD:
+		if money.FromProto(posPromotionRule.MinSubtotal) <= 0 {
+			return errors.New("minimum subtotal must be greater than zero")
+		}
+		if posPromotionRule.DiscountRate <= 0 && money.FromProto(posPromotionRule.DiscountAmount) <= 0 {
+			return errors.New("receipt threshold promotion needs a discount rate or a discount amount")
+		}
+		if posPromotionRule.DiscountRate > 1 {
+			return errors.New("discount rate must be a fraction between 0 and 1")
+		}
+	default:
+		return errors.New("invalid promotion rule type")
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/upstream"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type PosReceiptService interface {
+	CreatePosReceipt(ctx context.Context, req *pb.CreatePosReceiptRequest) (*pb.CreatePosReceiptResponse, error)
+	ReadPosReceipt(ctx context.Context, req *pb.ReadPosReceiptRequest) (*pb.ReadPosReceiptResponse, error)
+	VoidPosReceipt(ctx context.Context, req *pb.VoidPosReceiptRequest) (*pb.VoidPosReceiptResponse, error)
+	ReadAllPosReceipts(ctx context.Context, req *pb.ReadAllPosReceiptsRequest) (*pb.ReadAllPosReceiptsResponse, error)
+}
+
+type posReceiptService struct {
+	pb.UnimplementedPosReceiptServiceServer
+	receiptRepo   repository.PosReceiptRepository
+	drawerSession repository.PosDrawerSessionRepository
+	checkout      *posCheckout
+	idempotency   *IdempotencyKeys
+	payments      config.PaymentSettings
+	upstream      *upstream.Client
+}
+
+func NewPosReceiptService(receiptRepo repository.PosReceiptRepository, idempotencyKeys *IdempotencyKeys, checkoutRepo repository.PosCheckoutRepository, paymentMethod repository.PosPaymentMethodRepository, customer repository.PosCustomerRepository, taxRate repository.PosTaxRateRepository, promotionRule repository.PosPromotionRuleRepository, drawerSession repository.PosDrawerSessionRepository, payments config.PaymentSettings, upstreamClient *upstream.Client, logger *slog.Logger) *posReceiptService {
+	return &posReceiptService{
+		receiptRepo:   receiptRepo,
+		drawerSession: drawerSession,
+		checkout:      newPosCheckout(checkoutRepo, paymentMethod, customer, taxRate, promotionRule, drawerSession, payments, upstreamClient, logger),
+		idempotency:   idempotencyKeys,
+		payments:      payments,
+		upstream:      upstreamClient,
+	}
+}
+
+func (s *posReceiptService) CreatePosReceipt(ctx context.Context, req *pb.CreatePosReceiptRequest) (*pb.CreatePosReceiptResponse, error) {
+	respond := func(posReceipt *entity.PosReceipt) *pb.CreatePosReceiptResponse {
+		return &pb.CreatePosReceiptResponse{
+			PosReceipt: repository.PosReceiptToProto(posReceipt),
+		}
+	}
+
+	// A retried checkout gets the response of the first one
+	return checkoutOnce(ctx, s.idempotency, req, &pb.CreatePosReceiptResponse{}, func(key *entity.PosIdempotencyKey) (*pb.CreatePosReceiptResponse, error) {
+		posReceipt, err := s.checkout.run(ctx, &pb.CreatePosSalesRequest{
+			PosSales: req.PosSales,
+			Tenders:  req.Tenders,
+		}, key, func(posReceipt *entity.PosReceipt) proto.Message {
+			return respond(posReceipt)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return respond(posReceipt), nil
+	})
+}
+
+func (s *posReceiptService) ReadAllPosReceipts(ctx context.Context, req *pb.ReadAllPosReceiptsRequest) (*pb.ReadAllPosReceiptsResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paginationResult, err := s.receiptRepo.ReadAllPosReceipts(pagination, access.RoleName, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	posReceipts := paginationResult.Records.([]entity.PosReceipt)
+	pbPosReceipts := make([]*pb.PosReceipt, len(posReceipts))
+
+	for i := range posReceipts {
+		pbPosReceipts[i] = repository.PosReceiptToProto(&posReceipts[i])
+	}
+
+	return &pb.ReadAllPosReceiptsResponse{
+		PosReceipts:    pbPosReceipts,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
+		NextPageToken:  paginationResult.NextPageToken,
+		CountEstimated: paginationResult.CountEstimated,
+	}, nil
+}
+
+func (s *posReceiptService) ReadPosReceipt(ctx context.Context, req *pb.ReadPosReceiptRequest) (*pb.ReadPosReceiptResponse, error) {
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	posReceipt, err := s.receiptRepo.ReadPosReceipt(req.PosReceiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posReceipt.CompanyId, BranchID: posReceipt.BranchId, StoreID: posReceipt.StoreId}); err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadPosReceiptResponse{
+		PosReceipt: posReceipt,
+	}, nil
+}
+
+// VoidPosReceipt cancels a completed receipt, puts its items back into stock and takes back every tender
+func (s *posReceiptService) VoidPosReceipt(ctx context.Context, req *pb.VoidPosReceiptRequest) (*pb.VoidPosReceiptResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token := identity.Token
+
+	if req.Reason == "" {
+		return nil, errors.New("void reason is required")
+	}
+
+	posReceipt, err := s.receiptRepo.ReadPosReceipt(req.PosReceiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posReceipt.CompanyId, BranchID: posReceipt.BranchId, StoreID: posReceipt.StoreId}); err != nil {
+		return nil, err
+	}
+
+	if posReceipt.Status != entity.RECEIPT_STATUS_COMPLETED {
+		return nil, errors.New("only completed receipts can be voided")
+	}
+
+	// The reversals are booked in the currency of this deployment
+	if err := money.ValidateCurrency(posReceipt.Total); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	now := time.Now()
+	receiptVoid := &dto.PosReceiptVoid{
+		PosReceiptID: req.PosReceiptId,
+		Reason:       req.Reason,
+		VoidedBy:     uuid.MustParse(identity.Payload.UserId),
+		VoidedAt:     now,
+	}
+	if err := s.reverseTenders(receiptVoid, posReceipt, identity.Payload); err != nil {
+		return nil, err
+	}
+
+	saga := newCheckoutSaga()
+	// Compensations still have to run when the caller has gone away
+	compensationCtx := context.WithoutCancel(ctx)
+
+	// Put the voided items back into stock
+	for _, line := range posReceipt.Lines {
+		restock := &dto.PosInventoryHistory{
+			ProductId: line.ProductId,
+			StoreId:   posReceipt.StoreId,
+			Quantity:  line.Quantity,
+			BranchId:  posReceipt.BranchId,
+		}
+
+		_, err = s.upstream.CreatePosInventoryHistory(ctx, restock, identity.Payload, token)
+		if err != nil {
+			return nil, saga.abort(err)
+		}
+
+		// Take the stock out again if the receipt cannot be voided
+		stockOut := &dto.PosInventoryHistory{
+			ProductId: restock.ProductId,
+			StoreId:   restock.StoreId,
+			Quantity:  -line.Quantity,
+			BranchId:  restock.BranchId,
+		}
+		saga.addCompensation("stock out product "+stockOut.ProductId, func() error {
+			_, err := s.upstream.CreatePosInventoryHistory(compensationCtx, stockOut, identity.Payload, token)
+			return err
+		})
+	}
+
+	// The returns are checked again in the transaction, a receipt with returns cannot be voided
+	voidedPosReceipt, err := s.receiptRepo.VoidPosReceipt(receiptVoid)
+	if err != nil {
+		return nil, saga.abort(err)
+	}
+
+	return &pb.VoidPosReceiptResponse{
+		PosReceipt: voidedPosReceipt,
+	}, nil
+}
+
+// reverseTenders adds the records that take back every tender of the receipt: cash paid back from the drawer
+// of the voiding cashier, an invoice credit for pay later and a negative online payment for any other method.
+// The invoice credits carry the shares of the receipt discount and tax their invoices were booked with.
+func (s *posReceiptService) reverseTenders(receiptVoid *dto.PosReceiptVoid, posReceipt *pb.PosReceipt, jwtPayload *pb.JWTPayload) error {
+	tenderAmounts := make([]money.Amount, len(posReceipt.Tenders))
+	for i, tender := range posReceipt.Tenders {
+		tenderAmounts[i] = money.FromProto(tender.Amount)
+	}
+	discountShares := tenderShares(money.FromProto(posReceipt.DiscountTotal), tenderAmounts)
+	taxShares := tenderShares(money.FromProto(posReceipt.TaxTotal), tenderAmounts)
+
+	now := receiptVoid.VoidedAt
+	userID := uuid.MustParse(jwtPayload.UserId)
+	storeID := uuid.MustParse(posReceipt.StoreId)
+	branchID := uuid.MustParse(posReceipt.BranchId)
+	companyID := uuid.MustParse(posReceipt.CompanyId)
+
+	var drawerSession *entity.PosDrawerSession
+	for i, tender := range posReceipt.Tenders {
+		amount := tenderAmounts[i]
+
+		switch tender.PaymentMethodName {
+		case s.payments.CashMethod:
+			// The cash goes back out of a drawer, the drawer of the session the sale was counted in can be closed already
+			if drawerSession == nil {
+				session, err := s.drawerSession.ReadOpenPosDrawerSession(posReceipt.StoreId, jwtPayload.UserId)
+				if err != nil {
+					return err
+				}
+				if session == nil {
+					return status.Error(codes.FailedPrecondition, "open a drawer session before voiding a cash receipt")
+				}
+				drawerSession = session
+			}
+
+			receiptVoid.CashDrawers = append(receiptVoid.CashDrawers, &entity.PosCashDrawer{
+				DrawerID:        uuid.New(),
+				StoreID:         &storeID,
+				EmployeeID:      userID,
+				ReceiptID:       posReceipt.ReceiptId,
+				CashIn:          0,
+				Amount:          amount,
+				CashOut:         amount,
+				TransactionTime: now,
+				RoleID:          uuid.MustParse(jwtPayload.Role),
+				BranchID:        &branchID,
+				CompanyID:       companyID,
+				Description:     fmt.Sprintf("Void Receipt ID %s", posReceipt.ReceiptId),
+				CreatedAt:       now,
+				CreatedBy:       userID,
+				UpdatedAt:       now,
+				UpdatedBy:       userID,
+				SessionID:       &drawerSession.SessionID,
+			})
+		case s.payments.PayLaterMethod:
+			receiptVoid.Invoices = append(receiptVoid.Invoices, &entity.PosInvoice{
+				InvoiceID: uuid.New(),
+				ReceiptID: posReceipt.ReceiptId,
+				Date:      now,
+				Amount:    -amount,
+				Discounts: -discountShares[i],
+				Taxes:     -taxShares[i],
+				BranchID:  branchID,
+				CompanyID: companyID,
+				CreatedAt: now,
+				CreatedBy: userID,
+				UpdatedAt: now,
+				UpdatedBy: userID,
+			})
+		default:
+			receiptVoid.OnlinePayments = append(receiptVoid.OnlinePayments, &entity.PosOnlinePayment{
+				PaymentID:     uuid.New(),
+				StoreID:       storeID,
+				EmployeeID:    userID,
+				PaymentDate:   now,
+				ReceiptID:     posReceipt.ReceiptId,
+				Amount:        -amount,
+				PaymentMethod: uuid.MustParse(tender.PaymentMethodId),
+				RoleID:        uuid.MustParse(jwtPayload.Role),
+				BranchID:      branchID,
+				CompanyID:     companyID,
+				CreatedAt:     now,
+				CreatedBy:     userID,
+				UpdatedAt:     now,
+				UpdatedBy:     userID,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,546 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/upstream"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// MAX_SERIES_BUCKETS bounds the points of a time series, a month of hours or about eighty years of months
+const MAX_SERIES_BUCKETS = 1000
+
+type PosReportService interface {
+	GetDailyStoreSummary(ctx context.Context, req *pb.GetDailyStoreSummaryRequest) (*pb.GetDailyStoreSummaryResponse, error)
+	GetSalesTimeSeries(ctx context.Context, req *pb.GetSalesTimeSeriesRequest) (*pb.GetSalesTimeSeriesResponse, error)
+}
+
+type posReportService struct {
+	pb.UnimplementedPosReportServiceServer
+	reportRepo repository.PosReportRepository
+	upstream   *upstream.Client
+}
+
+func NewPosReportService(reportRepo repository.PosReportRepository, upstreamClient *upstream.Client) *posReportService {
+	return &posReportService{
+		reportRepo: reportRepo,
+		upstream:   upstreamClient,
+	}
+}
+
+// GetDailyStoreSummary builds the Z-report of a store, the business day runs from midnight to midnight in the time zone of the request
+func (s *posReportService) GetDailyStoreSummary(ctx context.Context, req *pb.GetDailyStoreSummaryRequest) (*pb.GetDailyStoreSummaryResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store users report on their own store, branch and company users name the store
+	storeID := req.StoreId
+	if access.Level == policy.STORE_USER && storeID == "" {
+		storeID = identity.Payload.StoreId
+	}
+
+	if storeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "store id is required")
+	}
+
+	from, to, location, err := businessDay(req.BusinessDate, req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	storeData, err := s.upstream.GetPosStoreById(ctx, storeID, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+	store := storeData.GetPosStore()
+	if store == nil {
+		return nil, status.Errorf(codes.NotFound, "store %s is not found", storeID)
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: store.CompanyId, BranchID: store.BranchId, StoreID: store.StoreId}); err != nil {
+		return nil, err
+	}
+
+	salesTotals, err := s.reportRepo.ReadPosCashierSalesTotals(storeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	returnTotals, err := s.reportRepo.ReadPosCashierReturnTotals(storeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	tenderTotals, err := s.reportRepo.ReadPosTenderTotals(storeID, store.BranchId, store.CompanyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := buildDailyStoreSummary(salesTotals, returnTotals, tenderTotals)
+	summary.StoreId = store.StoreId
+	summary.BranchId = store.BranchId
+	summary.CompanyId = store.CompanyId
+	summary.BusinessDate = from.Format(time.DateOnly)
+	summary.TimeZone = location.String()
+	summary.From = timestamppb.New(from)
+	summary.To = timestamppb.New(to)
+
+	return &pb.GetDailyStoreSummaryResponse{
+		Summary: summary,
+	}, nil
+}
+
+// loadLocation returns the IANA time zone of a request, UTC when none is given
+func loadLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", timeZone)
+	}
+	return location, nil
+}
+
+// businessDay returns the bounds of the day in the time zone, today when no date is given
+func businessDay(businessDate string, timeZone string) (time.Time, time.Time, *time.Location, error) {
+	location, err := loadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, err
+	}
+
+	if businessDate == "" {
+		businessDate = time.Now().In(location).Format(time.DateOnly)
+	}
+
+	from, err := time.ParseInLocation(time.DateOnly, businessDate, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, status.Errorf(codes.InvalidArgument, "business date %q must be YYYY-MM-DD", businessDate)
+	}
+
+	// AddDate keeps the day a calendar day when daylight saving time starts or ends
+	return from, from.AddDate(0, 0, 1), location, nil
+}
+
+// buildDailyStoreSummary adds up the cashier and tender totals, a cashier who only refunded is listed as well
+func buildDailyStoreSummary(salesTotals []dto.PosCashierSalesTotals, returnTotals []dto.PosCashierReturnTotals, tenderTotals []dto.PosTenderTotals) *pb.PosDailyStoreSummary {
+	var grossSales, discounts, taxes, returns, returnTaxes money.Amount
+	var transactions, returnCount int
+
+	cashiers := []*pb.PosCashierTotal{}
+	cashierReturns := make(map[string]dto.PosCashierReturnTotals, len(returnTotals))
+	for _, total := range returnTotals {
+		cashierReturns[total.CashierID] = total
+		returns += total.Returns
+		returnTaxes += total.ReturnTaxes
+		returnCount += total.Count
+	}
+
+	for _, total := range salesTotals {
+		grossSales += total.GrossSales
+		discounts += total.Discounts
+		taxes += total.Taxes
+		transactions += total.Transactions
+
+		refunded := cashierReturns[total.CashierID]
+		delete(cashierReturns, total.CashierID)
+
+		cashiers = append(cashiers, &pb.PosCashierTotal{
+			CashierId:        total.CashierID,
+			GrossSales:       money.ToProto(total.GrossSales),
+			Discounts:        money.ToProto(total.Discounts),
+			Taxes:            money.ToProto(total.Taxes),
+			Returns:          money.ToProto(refunded.Returns),
+			NetSales:         money.ToProto(netSales(total.GrossSales, total.Discounts, refunded.Returns, refunded.ReturnTaxes)),
+			TransactionCount: int32(total.Transactions),
+			ReturnCount:      int32(refunded.Count),
+		})
+	}
+
+	// Kept in the order of the repository, cashiers who only refunded come last
+	for _, total := range returnTotals {
+		if _, ok := cashierReturns[total.CashierID]; !ok {
+			continue
+		}
+		cashiers = append(cashiers, &pb.PosCashierTotal{
+			CashierId:   total.CashierID,
+			GrossSales:  money.ToProto(0),
+			Discounts:   money.ToProto(0),
+			Taxes:       money.ToProto(0),
+			Returns:     money.ToProto(total.Returns),
+			NetSales:    money.ToProto(netSales(0, 0, total.Returns, total.ReturnTaxes)),
+			ReturnCount: int32(total.Count),
+		})
+	}
+
+	paymentMethods := make([]*pb.PosPaymentMethodTotal, 0, len(tenderTotals))
+	for _, total := range tenderTotals {
+		paymentMethods = append(paymentMethods, &pb.PosPaymentMethodTotal{
+			PaymentMethodId:  total.PaymentMethodID,
+			MethodName:       total.MethodName,
+			Sales:            money.ToProto(total.Sales),
+			Refunds:          money.ToProto(total.Refunds),
+			Net:              money.ToProto(total.Sales - total.Refunds),
+			TransactionCount: int32(total.Transactions),
+		})
+	}
+
+	var averageBasket money.Amount
+	if transactions > 0 {
+		averageBasket = (grossSales - discounts).DivRate(float64(transactions))
+	}
+
+	return &pb.PosDailyStoreSummary{
+		GrossSales:       money.ToProto(grossSales),
+		Discounts:        money.ToProto(discounts),
+		Taxes:            money.ToProto(taxes),
+		Returns:          money.ToProto(returns),
+		NetSales:         money.ToProto(netSales(grossSales, discounts, returns, returnTaxes)),
+		TransactionCount: int32(transactions),
+		ReturnCount:      int32(returnCount),
+		AverageBasket:    money.ToProto(averageBasket),
+		PaymentMethods:   paymentMethods,
+		Cashiers:         cashiers,
+	}
+}
+
+// GetSalesTimeSeries reads the sales of the caller's company or branch from the hourly rollups, bucketed by the interval
+// in the time zone of the request. With compare_previous every series carries the same number of buckets before from.
+func (s *posReportService) GetSalesTimeSeries(ctx context.Context, req *pb.GetSalesTimeSeriesRequest) (*pb.GetSalesTimeSeriesResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := req.Interval
+	if interval == "" {
+		interval = dto.SERIES_INTERVAL_DAY
+	}
+
+	switch interval {
+	case dto.SERIES_INTERVAL_HOUR, dto.SERIES_INTERVAL_DAY, dto.SERIES_INTERVAL_WEEK, dto.SERIES_INTERVAL_MONTH:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "interval must be hour, day, week or month, not %q", interval)
+	}
+
+	switch req.GroupBy {
+	case "", dto.SERIES_GROUP_BY_STORE, dto.SERIES_GROUP_BY_BRANCH, dto.SERIES_GROUP_BY_PAYMENT_METHOD:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "group by must be store, branch or payment_method, not %q", req.GroupBy)
+	}
+
+	for name, id := range map[string]string{"branch id": req.BranchId, "store id": req.StoreId, "payment method id": req.PaymentMethodId} {
+		if _, err := uuid.Parse(id); id != "" && err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s %q is not a valid UUID", name, id)
+		}
+	}
+
+	location, err := loadLocation(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.From == nil {
+		return nil, status.Error(codes.InvalidArgument, "from is required")
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	first := bucketStart(req.From.AsTime().In(location), interval)
+	buckets := 0
+	for nextBucket(first, interval, buckets).Before(to) {
+		buckets++
+		if buckets > MAX_SERIES_BUCKETS {
+			return nil, status.Errorf(codes.InvalidArgument, "the period has more than %d %s buckets, use a longer interval", MAX_SERIES_BUCKETS, interval)
+		}
+	}
+
+	if buckets == 0 {
+		return nil, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+
+	query := dto.PosSalesSeriesQuery{
+		Interval:        interval,
+		Location:        location,
+		GroupBy:         req.GroupBy,
+		From:            first,
+		To:              nextBucket(first, interval, buckets),
+		BranchID:        req.BranchId,
+		StoreID:         req.StoreId,
+		PaymentMethodID: req.PaymentMethodId,
+	}
+
+	rows, err := s.reportRepo.ReadPosSalesSeries(query, access.RoleName, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetSalesTimeSeriesResponse{
+		Interval: interval,
+		TimeZone: location.String(),
+		From:     timestamppb.New(query.From),
+		To:       timestamppb.New(query.To),
+	}
+
+	series := newSalesSeriesSet(first, interval, buckets)
+	series.add(rows, false)
+
+	// The previous period ends where this one starts, month buckets keep their calendar months
+	if req.ComparePrevious {
+		query.To = query.From
+		query.From = nextBucket(first, interval, -buckets)
+
+		previousRows, err := s.reportRepo.ReadPosSalesSeries(query, access.RoleName, identity.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		series.compared = true
+		series.previousFirst = query.From
+		series.add(previousRows, true)
+		resp.PreviousFrom = timestamppb.New(query.From)
+	}
+
+	resp.Series = series.toProto(req.GroupBy == "")
+	return resp, nil
+}
+
+// bucketStart rounds a time in its location down to the start of its bucket
+func bucketStart(t time.Time, interval string) time.Time {
+	year, month, day := t.Date()
+
+	switch interval {
+	case dto.SERIES_INTERVAL_HOUR:
+		// Hour buckets are the UTC hours of the rollups, in a location with a half hour offset they start at half past
+		return t.Truncate(time.Hour)
+	case dto.SERIES_INTERVAL_WEEK:
+		// Weeks start on Monday, like date_trunc
+		return time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+	case dto.SERIES_INTERVAL_MONTH:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// nextBucket returns the start of the bucket n buckets after first, n may be negative
+func nextBucket(first time.Time, interval string, n int) time.Time {
+	switch interval {
+	case dto.SERIES_INTERVAL_HOUR:
+		return first.Add(time.Duration(n) * time.Hour)
+	case dto.SERIES_INTERVAL_WEEK:
+		return first.AddDate(0, 0, 7*n)
+	case dto.SERIES_INTERVAL_MONTH:
+		return first.AddDate(0, n, 0)
+	default:
+		return first.AddDate(0, 0, n)
+	}
+}
+
+// salesTotals adds up rollup rows
+type salesTotals struct {
+	grossSales   money.Amount
+	discounts    money.Amount
+	taxes        money.Amount
+	returns      money.Amount
+	returnTaxes  money.Amount
+	transactions int
+}
+
+func (t *salesTotals) add(row dto.PosSalesSeriesRow) {
+	t.grossSales += row.GrossSales
+	t.discounts += row.Discounts
+	t.taxes += row.Taxes
+	t.returns += row.Returns
+	t.returnTaxes += row.ReturnTaxes
+	t.transactions += row.Transactions
+}
+
+func (t *salesTotals) merge(other salesTotals) {
+	t.grossSales += other.grossSales
+	t.discounts += other.discounts
+	t.taxes += other.taxes
+	t.returns += other.returns
+	t.returnTaxes += other.returnTaxes
+	t.transactions += other.transactions
+}
+
+func (t salesTotals) netSales() money.Amount {
+	return netSales(t.grossSales, t.discounts, t.returns, t.returnTaxes)
+}
+
+// netSales takes the discounts and returns off the gross sales. Gross sales and discounts leave exclusive tax out
+// while a refund pays it back, so the exclusive tax refunded is not taken off with the returns.
+func netSales(grossSales money.Amount, discounts money.Amount, returns money.Amount, returnTaxes money.Amount) money.Amount {
+	return grossSales - discounts - (returns - returnTaxes)
+}
+
+func (t salesTotals) toProto() *pb.PosSalesTotals {
+	return &pb.PosSalesTotals{
+		GrossSales:       money.ToProto(t.grossSales),
+		Discounts:        money.ToProto(t.discounts),
+		Taxes:            money.ToProto(t.taxes),
+		Returns:          money.ToProto(t.returns),
+		NetSales:         money.ToProto(t.netSales()),
+		TransactionCount: int32(t.transactions),
+	}
+}
+
+// salesSeries holds one series with a point for every bucket, also the buckets without sales
+type salesSeries struct {
+	key      string
+	label    string
+	points   []salesTotals
+	previous []salesTotals
+}
+
+// salesSeriesSet places the rollup rows in the buckets of their series
+type salesSeriesSet struct {
+	first         time.Time
+	previousFirst time.Time
+	interval      string
+	buckets       int
+	compared      bool
+	series        map[string]*salesSeries
+}
+
+func newSalesSeriesSet(first time.Time, interval string, buckets int) *salesSeriesSet {
+	return &salesSeriesSet{
+		first:    first,
+		interval: interval,
+		buckets:  buckets,
+		series:   map[string]*salesSeries{},
+	}
+}
+
+func (s *salesSeriesSet) get(key string) *salesSeries {
+	series, ok := s.series[key]
+	if !ok {
+		series = &salesSeries{
+			key:      key,
+			points:   make([]salesTotals, s.buckets),
+			previous: make([]salesTotals, s.buckets),
+		}
+		s.series[key] = series
+	}
+	return series
+}
+
+func (s *salesSeriesSet) add(rows []dto.PosSalesSeriesRow, previous bool) {
+	first := s.first
+	if previous {
+		first = s.previousFirst
+	}
+
+	index := make(map[int64]int, s.buckets)
+	for i := 0; i < s.buckets; i++ {
+		index[nextBucket(first, s.interval, i).Unix()] = i
+	}
+
+	for _, row := range rows {
+		i, ok := index[row.Bucket.Unix()]
+		if !ok {
+			continue
+		}
+
+		series := s.get(row.SeriesKey)
+		if row.Label != "" {
+			series.label = row.Label
+		}
+
+		if previous {
+			series.previous[i].add(row)
+		} else {
+			series.points[i].add(row)
+		}
+	}
+}
+
+// toProto lists the series by key, without a breakdown there is always the one series even when nothing was sold
+func (s *salesSeriesSet) toProto(single bool) []*pb.PosSalesSeries {
+	if single {
+		s.get("")
+	}
+
+	keys := make([]string, 0, len(s.series))
+	for key := range s.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pbSeries := make([]*pb.PosSalesSeries, 0, len(keys))
+	for _, key := range keys {
+		series := s.series[key]
+
+		var totals salesTotals
+		points := make([]*pb.PosSalesPoint, s.buckets)
+		for i, point := range series.points {
+			totals.merge(point)
+			points[i] = &pb.PosSalesPoint{
+				BucketStart: timestamppb.New(nextBucket(s.first, s.interval, i)),
+				Totals:      point.toProto(),
+			}
+		}
+
+		pbSeries = append(pbSeries, &pb.PosSalesSeries{
+			Key:    series.key,
+			Label:  series.label,
+			Points: points,
+			Totals: totals.toProto(),
+		})
+
+		if !s.compared {
+			continue
+		}
+
+		var previousTotals salesTotals
+		previousPoints := make([]*pb.PosSalesPoint, s.buckets)
+		for i, point := range series.previous {
+			previousTotals.merge(point)
+			previousPoints[i] = &pb.PosSalesPoint{
+				BucketStart: timestamppb.New(nextBucket(s.previousFirst, s.interval, i)),
+				Totals:      point.toProto(),
+			}
+		}
+
+		current := pbSeries[len(pbSeries)-1]
+		current.PreviousPoints = previousPoints
+		current.PreviousTotals = previousTotals.toProto()
+		if previousTotals.netSales() != 0 {
+			change := float64(totals.netSales()-previousTotals.netSales()) / math.Abs(float64(previousTotals.netSales()))
+			current.NetSalesChange = wrapperspb.Double(change)
+		}
+	}
+
+	return pbSeries
+}
@@ -3,16 +3,23 @@ package service
 import (
 	"context"
 	"errors"
-	"os"
+	"fmt"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/upstream"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -26,60 +33,200 @@ type PosReturnService interface {
 
 type posReturnService struct {
 	pb.UnimplementedPosReturnServiceServer
-	returnRepo         repository.PosReturnRepository
-	CompanyServiceConn *grpc.ClientConn
+	returnRepo  repository.PosReturnRepository
+	receiptRepo repository.PosReceiptRepository
+	sessionRepo repository.PosDrawerSessionRepository
+	payments    config.PaymentSettings
+	upstream    *upstream.Client
 }
 
-func NewPosReturnService(returnRepo repository.PosReturnRepository, companyServiceConn *grpc.ClientConn) *posReturnService {
+func NewPosReturnService(returnRepo repository.PosReturnRepository, receiptRepo repository.PosReceiptRepository, sessionRepo repository.PosDrawerSessionRepository, payments config.PaymentSettings, upstreamClient *upstream.Client) *posReturnService {
 	return &posReturnService{
-		returnRepo:         returnRepo,
-		CompanyServiceConn: companyServiceConn,
+		returnRepo:  returnRepo,
+		receiptRepo: receiptRepo,
+		sessionRepo: sessionRepo,
+		payments:    payments,
+		upstream:    upstreamClient,
 	}
 }
 
 func (s *posReturnService) CreatePosReturn(ctx context.Context, req *pb.CreatePosReturnRequest) (*pb.CreatePosReturnResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to create new return")
+	token := identity.Token
+
+	if req.PosReturn == nil || req.PosReturn.ReceiptId == "" {
+		return nil, errors.New("receipt id is required")
 	}
 
-	req.PosReturn.ReturnId = uuid.New().String() // Generate a new UUID for the return_id
+	if req.PosReturn.Quantity <= 0 {
+		return nil, errors.New("return quantity must be greater than zero")
+	}
 
-	now := timestamppb.New(time.Now())
-	req.PosReturn.CreatedAt = now
-	req.PosReturn.UpdatedAt = now
+	// Store users return items of their own store, branch users name the store of the receipt
+	if access.Level == policy.STORE_USER && req.PosReturn.StoreId == "" {
+		req.PosReturn.StoreId = identity.Payload.StoreId
+	}
+
+	if req.PosReturn.StoreId == "" {
+		return nil, errors.New("store id is required")
+	}
+
+	// The original receipt decides what can be returned and how it is refunded
+	posReceipt, err := s.receiptRepo.ReadPosReceiptByReceiptID(req.PosReturn.StoreId, req.PosReturn.ReceiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posReceipt.CompanyID.String(), BranchID: posReceipt.BranchID.String(), StoreID: posReceipt.StoreID.String()}); err != nil {
+		return nil, err
+	}
+
+	if posReceipt.Status != entity.RECEIPT_STATUS_COMPLETED {
+		return nil, errors.New("only completed receipts can be returned")
+	}
+
+	// The refund is booked in the currency of this deployment
+	if err := money.ValidateCurrencyCode(posReceipt.CurrencyCode); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	line, err := findReturnLine(posReceipt, req.PosReturn)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := findRefundTender(posReceipt, req.PosReturn.TenderId, req.PosReturn.PaymentMethodId)
+	if err != nil {
+		return nil, err
+	}
+
+	returnedQuantity, err := s.returnRepo.ReadPosReturnedQuantity(line.SaleID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if int(req.PosReturn.Quantity) > line.Quantity-returnedQuantity {
+		return nil, fmt.Errorf("only %d of the %d sold items can still be returned", line.Quantity-returnedQuantity, line.Quantity)
+	}
+
+	// Refund what the customer paid for the line, its discounts included and its tax exclusive amount added
+	// The exclusive tax refunded is kept apart, the sales reports count sales without it
+	paidForLine := line.TotalPrice
+	var exclusiveTax money.Amount
+	if !line.TaxInclusive {
+		exclusiveTax = line.TaxAmount
+		paidForLine += exclusiveTax
+	}
+	amount := paidForLine.Times(int(req.PosReturn.Quantity)).DivRate(float64(line.Quantity))
+	taxAmount := exclusiveTax.Times(int(req.PosReturn.Quantity)).DivRate(float64(line.Quantity))
+
+	now := time.Now()
+	req.PosReturn.ReturnId = uuid.New().String() // Generate a new UUID for the return_id
+	req.PosReturn.PosReceiptId = posReceipt.PosReceiptID.String()
+	req.PosReturn.SaleId = line.SaleID.String()
+	req.PosReturn.ProductId = line.ProductID.String()
+	req.PosReturn.PaymentMethodId = tender.PaymentMethodID.String()
+	req.PosReturn.TenderId = tender.TenderID.String()
+	req.PosReturn.Price = money.ToProto(paidForLine.DivRate(float64(line.Quantity)))
+	req.PosReturn.Amount = money.ToProto(amount)
+	req.PosReturn.TaxAmount = money.ToProto(taxAmount)
+	req.PosReturn.BranchId = posReceipt.BranchID.String()
+	req.PosReturn.CompanyId = posReceipt.CompanyID.String()
+	req.PosReturn.CreatedAt = timestamppb.New(now)
+	req.PosReturn.CreatedBy = identity.Payload.UserId
+	req.PosReturn.UpdatedAt = timestamppb.New(now)
+	req.PosReturn.UpdatedBy = identity.Payload.UserId
+	if req.PosReturn.ReturnDate == nil {
+		req.PosReturn.ReturnDate = timestamppb.New(now)
+	}
 
 	// Convert pb.PosReturn to entity.PosReturn
 	gormReturn := &entity.PosReturn{
-		ReturnID:   uuid.MustParse(req.PosReturn.ReturnId),
-		ReceiptID:  req.PosReturn.ReceiptId,
-		ProductID:  uuid.MustParse(req.PosReturn.ProductId),
-		Quantity:   int(req.PosReturn.Quantity),
-		Price:      req.PosReturn.Price,
-		Amount:     req.PosReturn.Amount,
-		ReturnDate: req.PosReturn.ReturnDate.AsTime(),
-		Reason:     req.PosReturn.Reason,
-		StoreID:    uuid.MustParse(req.PosReturn.StoreId),
-		BranchID:   uuid.MustParse(req.JwtPayload.BranchId),
-		CompanyID:  uuid.MustParse(req.JwtPayload.CompanyId),
-		CreatedAt:  req.PosReturn.CreatedAt.AsTime(),
-		CreatedBy:  uuid.MustParse(req.JwtPayload.UserId),
-		UpdatedAt:  req.PosReturn.UpdatedAt.AsTime(),
-		UpdatedBy:  uuid.MustParse(req.JwtPayload.UserId),
-	}
-
-	err = s.returnRepo.CreatePosReturn(gormReturn)
+		ReturnID:        uuid.MustParse(req.PosReturn.ReturnId),
+		ReceiptID:       posReceipt.ReceiptID,
+		PosReceiptID:    posReceipt.PosReceiptID,
+		SaleID:          line.SaleID,
+		PaymentMethodID: tender.PaymentMethodID,
+		TenderID:        &tender.TenderID,
+		ProductID:       line.ProductID,
+		Quantity:        int(req.PosReturn.Quantity),
+		Price:           money.FromProto(req.PosReturn.Price),
+		Amount:          money.FromProto(req.PosReturn.Amount),
+		TaxAmount:       taxAmount,
+		ReturnDate:      req.PosReturn.ReturnDate.AsTime(),
+		Reason:          req.PosReturn.Reason,
+		StoreID:         posReceipt.StoreID,
+		BranchID:        posReceipt.BranchID,
+		CompanyID:       posReceipt.CompanyID,
+		CreatedAt:       now,
+		CreatedBy:       uuid.MustParse(identity.Payload.UserId),
+		UpdatedAt:       now,
+		UpdatedBy:       uuid.MustParse(identity.Payload.UserId),
+	}
+
+	refund := &dto.PosReturnRefund{
+		Return: gormReturn,
+	}
+	s.bookRefund(refund, tender, identity.Payload, now)
+
+	// Cash paid back by the cashier leaves the cashier's open drawer session
+	if refund.CashDrawer != nil {
+		drawerSession, err := s.sessionRepo.ReadOpenPosDrawerSession(posReceipt.StoreID.String(), identity.Payload.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if drawerSession == nil {
+			return nil, status.Error(codes.FailedPrecondition, "open a drawer session before refunding cash")
+		}
+		refund.CashDrawer.SessionID = &drawerSession.SessionID
+	}
+
+	saga := newCheckoutSaga()
+	// Compensations still have to run when the caller has gone away
+	compensationCtx := context.WithoutCancel(ctx)
+
+	// Put the returned items back into stock
+	restock := &dto.PosInventoryHistory{
+		ProductId: gormReturn.ProductID.String(),
+		StoreId:   gormReturn.StoreID.String(),
+		Quantity:  int32(gormReturn.Quantity),
+		BranchId:  gormReturn.BranchID.String(),
+	}
+
+	_, err = s.upstream.CreatePosInventoryHistory(ctx, restock, identity.Payload, token)
 	if err != nil {
-		return nil, err
+		return nil, saga.abort(err)
+	}
+
+	// Take the stock out again if the return cannot be stored
+	stockOut := &dto.PosInventoryHistory{
+		ProductId: restock.ProductId,
+		StoreId:   restock.StoreId,
+		Quantity:  -restock.Quantity,
+		BranchId:  restock.BranchId,
+	}
+	saga.addCompensation("stock out product "+stockOut.ProductId, func() error {
+		_, err := s.upstream.CreatePosInventoryHistory(compensationCtx, stockOut, identity.Payload, token)
+		return err
+	})
+
+	// insert the return and its refund in one transaction
+	if err := s.returnRepo.CreatePosReturnRefund(refund); err != nil {
+		return nil, saga.abort(err)
+	}
+
+	metrics.ObserveReturn(gormReturn.StoreID.String(), tender.PaymentMethodName, gormReturn.Amount)
+	if refund.CashDrawer != nil {
+		metrics.ObserveDrawerEntries(gormReturn.StoreID.String(), "return", 1)
 	}
 
 	return &pb.CreatePosReturnResponse{
@@ -87,27 +234,127 @@ func (s *posReturnService) CreatePosReturn(ctx context.Context, req *pb.CreatePo
 	}, nil
 }
 
-func (s *posReturnService) ReadAllPosReturns(ctx context.Context, req *pb.ReadAllPosReturnsRequest) (*pb.ReadAllPosReturnsResponse, error) {
-	pagination := dto.Pagination{
-		Limit: int(req.Limit),
-		Page:  int(req.Page),
+// bookRefund adds the refund record matching the tender type: cash leaves the drawer,
+// pay later gets an invoice credit and any other method an online payment refund.
+// Invoice credits and online refunds are recorded with a negative amount.
+func (s *posReturnService) bookRefund(refund *dto.PosReturnRefund, tender *entity.PosReceiptTender, jwtPayload *pb.JWTPayload, now time.Time) {
+	posReturn := refund.Return
+
+	switch tender.PaymentMethodName {
+	case s.payments.CashMethod:
+		refund.CashDrawer = &entity.PosCashDrawer{
+			DrawerID:        uuid.New(),
+			StoreID:         utils.ParseUUID(posReturn.StoreID.String()),
+			EmployeeID:      uuid.MustParse(jwtPayload.UserId),
+			ReceiptID:       posReturn.ReceiptID,
+			CashIn:          0,
+			Amount:          posReturn.Amount,
+			CashOut:         posReturn.Amount,
+			TransactionTime: now,
+			RoleID:          uuid.MustParse(jwtPayload.Role),
+			BranchID:        utils.ParseUUID(posReturn.BranchID.String()),
+			CompanyID:       posReturn.CompanyID,
+			Description:     fmt.Sprintf("Return Receipt ID %s", posReturn.ReceiptID),
+			CreatedAt:       now,
+			CreatedBy:       uuid.MustParse(jwtPayload.UserId),
+			UpdatedAt:       now,
+			UpdatedBy:       uuid.MustParse(jwtPayload.UserId),
+		}
+	case s.payments.PayLaterMethod:
+		refund.Invoice = &entity.PosInvoice{
+			InvoiceID: uuid.New(),
+			ReceiptID: posReturn.ReceiptID,
+			Date:      now,
+			Amount:    -posReturn.Amount,
+			BranchID:  posReturn.BranchID,
+			CompanyID: posReturn.CompanyID,
+			CreatedAt: now,
+			CreatedBy: uuid.MustParse(jwtPayload.UserId),
+			UpdatedAt: now,
+			UpdatedBy: uuid.MustParse(jwtPayload.UserId),
+		}
+	default:
+		refund.OnlinePayment = &entity.PosOnlinePayment{
+			PaymentID:     uuid.New(),
+			StoreID:       posReturn.StoreID,
+			EmployeeID:    uuid.MustParse(jwtPayload.UserId),
+			PaymentDate:   now,
+			ReceiptID:     posReturn.ReceiptID,
+			Amount:        -posReturn.Amount,
+			PaymentMethod: tender.PaymentMethodID,
+			RoleID:        uuid.MustParse(jwtPayload.Role),
+			BranchID:      posReturn.BranchID,
+			CompanyID:     posReturn.CompanyID,
+			CreatedAt:     now,
+			CreatedBy:     uuid.MustParse(jwtPayload.UserId),
+			UpdatedAt:     now,
+			UpdatedBy:     uuid.MustParse(jwtPayload.UserId),
+		}
+	}
+}
+
+// findReturnLine returns the receipt line of the returned sale, picked by sale id or else by product id
+func findReturnLine(posReceipt *entity.PosReceipt, posReturn *pb.PosReturn) (*entity.PosReceiptLine, error) {
+	for i := range posReceipt.Lines {
+		line := &posReceipt.Lines[i]
+		if posReturn.SaleId != "" {
+			if line.SaleID.String() == posReturn.SaleId {
+				return line, nil
+			}
+			continue
+		}
+
+		if line.ProductID.String() == posReturn.ProductId {
+			return line, nil
+		}
+	}
+
+	return nil, errors.New("receipt does not contain the returned product")
+}
+
+// findRefundTender returns the receipt tender to refund, the first tender when no payment method is given
+func findRefundTender(posReceipt *entity.PosReceipt, tenderID string, paymentMethodID string) (*entity.PosReceiptTender, error) {
+	// A tender id picks one of several tenders paid with the same payment method
+	if tenderID != "" {
+		for i := range posReceipt.Tenders {
+			tender := &posReceipt.Tenders[i]
+			if tender.TenderID.String() == tenderID {
+				return tender, nil
+			}
+		}
+
+		return nil, errors.New("receipt has no such refund tender")
+	}
+
+	for i := range posReceipt.Tenders {
+		tender := &posReceipt.Tenders[i]
+		if paymentMethodID == "" || tender.PaymentMethodID.String() == paymentMethodID {
+			return tender, nil
+		}
 	}
 
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
+	return nil, errors.New("receipt was not paid with the refund payment method")
+}
 
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+func (s *posReturnService) ReadAllPosReturns(ctx context.Context, req *pb.ReadAllPosReturnsRequest) (*pb.ReadAllPosReturnsResponse, error) {
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to read all return")
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	paginationResult, err := s.returnRepo.ReadAllPosReturns(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	paginationResult, err := s.returnRepo.ReadAllPosReturns(pagination, access.RoleName, identity.Payload, req.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -117,74 +364,55 @@ func (s *posReturnService) ReadAllPosReturns(ctx context.Context, req *pb.ReadAl
 
 	for i, posReturn := range posReturns {
 		pbPosReturns[i] = &pb.PosReturn{
-			ReturnId:   posReturn.ReturnID.String(),
-			ReceiptId:  posReturn.ReceiptID,
-			ProductId:  posReturn.ProductID.String(),
-			Quantity:   int32(posReturn.Quantity),
-			Price:      float64(posReturn.Price),
-			Amount:     float64(posReturn.Amount),
-			ReturnDate: timestamppb.New(posReturn.ReturnDate),
-			Reason:     posReturn.Reason,
-			StoreId:    posReturn.StoreID.String(),
-			BranchId:   posReturn.BranchID.String(),
-			CompanyId:  posReturn.CompanyID.String(),
-			CreatedAt:  timestamppb.New(posReturn.CreatedAt),
-			CreatedBy:  posReturn.CreatedBy.String(),
-			UpdatedAt:  timestamppb.New(posReturn.UpdatedAt),
-			UpdatedBy:  posReturn.UpdatedBy.String(),
+			ReturnId:        posReturn.ReturnID.String(),
+			ReceiptId:       posReturn.ReceiptID,
+			PosReceiptId:    posReturn.PosReceiptID.String(),
+			SaleId:          posReturn.SaleID.String(),
+			PaymentMethodId: posReturn.PaymentMethodID.String(),
+			ProductId:       posReturn.ProductID.String(),
+			Quantity:        int32(posReturn.Quantity),
+			Price:           money.ToProto(posReturn.Price),
+			Amount:          money.ToProto(posReturn.Amount),
+			TaxAmount:       money.ToProto(posReturn.TaxAmount),
+			ReturnDate:      timestamppb.New(posReturn.ReturnDate),
+			Reason:          posReturn.Reason,
+			StoreId:         posReturn.StoreID.String(),
+			BranchId:        posReturn.BranchID.String(),
+			CompanyId:       posReturn.CompanyID.String(),
+			CreatedAt:       timestamppb.New(posReturn.CreatedAt),
+			CreatedBy:       posReturn.CreatedBy.String(),
+			UpdatedAt:       timestamppb.New(posReturn.UpdatedAt),
+			UpdatedBy:       posReturn.UpdatedBy.String(),
+		}
+		if posReturn.TenderID != nil {
+			pbPosReturns[i].TenderId = posReturn.TenderID.String()
 		}
-
 	}
 
 	return &pb.ReadAllPosReturnsResponse{
-		PosReturns: pbPosReturns,
-		Limit:      int32(pagination.Limit),
-		Page:       int32(pagination.Page),
-		MaxPage:    int32(paginationResult.TotalPages),
-		Count:      paginationResult.TotalRecords,
+		PosReturns:     pbPosReturns,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
+		NextPageToken:  paginationResult.NextPageToken,
+		CountEstimated: paginationResult.CountEstimated,
 	}, nil
 }
 
 func (s *posReturnService) ReadPosReturn(ctx context.Context, req *pb.ReadPosReturnRequest) (*pb.ReadPosReturnResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to read return")
-	}
-
 	posReturn, err := s.returnRepo.ReadPosReturn(req.ReturnId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posReturn.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only retrieve return data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posReturn.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only retrieve return data within their branch")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posReturn.StoreId, req.JwtPayload.StoreId) {
-			return nil, errors.New("store users can only retrieve return data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posReturn.CompanyId, BranchID: posReturn.BranchId, StoreID: posReturn.StoreId, OwnerID: posReturn.CreatedBy}); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPosReturnResponse{
@@ -193,18 +421,14 @@ func (s *posReturnService) ReadPosReturn(ctx context.Context, req *pb.ReadPosRet
 }
 
 func (s *posReturnService) UpdatePosReturn(ctx context.Context, req *pb.UpdatePosReturnRequest) (*pb.UpdatePosReturnResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant update return data")
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the return to be updated
@@ -213,33 +437,38 @@ func (s *posReturnService) UpdatePosReturn(ctx context.Context, req *pb.UpdatePo
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posReturn.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only update return data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posReturn.CompanyId, BranchID: posReturn.BranchId, StoreID: posReturn.StoreId, OwnerID: posReturn.CreatedBy}); err != nil {
+		return nil, err
 	}
 
 	now := timestamppb.New(time.Now())
 	req.PosReturn.UpdatedAt = now
 
+	// The returned items were restocked and refunded already, only the reason can change
 	newReturnData := &entity.PosReturn{
-		ReturnID:   uuid.MustParse(posReturn.ReturnId),
-		ReceiptID:  posReturn.ReceiptId,
-		ProductID:  uuid.MustParse(req.PosReturn.ProductId),
-		Quantity:   int(req.PosReturn.Quantity),
-		Price:      posReturn.Price,
-		Amount:     posReturn.Amount,
-		ReturnDate: posReturn.ReturnDate.AsTime(),
-		Reason:     req.PosReturn.Reason,
-		StoreID:    uuid.MustParse(posReturn.StoreId),
-		BranchID:   uuid.MustParse(req.JwtPayload.BranchId),
-		CompanyID:  uuid.MustParse(req.JwtPayload.CompanyId),
-		CreatedAt:  posReturn.CreatedAt.AsTime(),
-		CreatedBy:  uuid.MustParse(posReturn.CreatedBy),
-		UpdatedAt:  req.PosReturn.UpdatedAt.AsTime(),
-		UpdatedBy:  uuid.MustParse(req.JwtPayload.UserId),
+		ReturnID:        uuid.MustParse(posReturn.ReturnId),
+		ReceiptID:       posReturn.ReceiptId,
+		PosReceiptID:    uuid.MustParse(posReturn.PosReceiptId),
+		SaleID:          uuid.MustParse(posReturn.SaleId),
+		PaymentMethodID: uuid.MustParse(posReturn.PaymentMethodId),
+		ProductID:       uuid.MustParse(posReturn.ProductId),
+		Quantity:        int(posReturn.Quantity),
+		Price:           money.FromProto(posReturn.Price),
+		Amount:          money.FromProto(posReturn.Amount),
+		TaxAmount:       money.FromProto(posReturn.TaxAmount),
+		ReturnDate:      posReturn.ReturnDate.AsTime(),
+		Reason:          req.PosReturn.Reason,
+		StoreID:         uuid.MustParse(posReturn.StoreId),
+		BranchID:        uuid.MustParse(identity.Payload.BranchId),
+		CompanyID:       uuid.MustParse(identity.Payload.CompanyId),
+		CreatedAt:       posReturn.CreatedAt.AsTime(),
+		CreatedBy:       uuid.MustParse(posReturn.CreatedBy),
+		UpdatedAt:       req.PosReturn.UpdatedAt.AsTime(),
+		UpdatedBy:       uuid.MustParse(identity.Payload.UserId),
+	}
+	if posReturn.TenderId != "" {
+		tenderID := uuid.MustParse(posReturn.TenderId)
+		newReturnData.TenderID = &tenderID
 	}
 
 	// Update the return
@@ -254,32 +483,25 @@ func (s *posReturnService) UpdatePosReturn(ctx context.Context, req *pb.UpdatePo
 }
 
 func (s *posReturnService) DeletePosReturn(ctx context.Context, req *pb.DeletePosReturnRequest) (*pb.DeletePosReturnResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant delete return data")
-	}
-
 	// Get the return to be updated
 	posReturn, err := s.returnRepo.ReadPosReturn(req.ReturnId)
 	if err != nil {
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	if err := access.Authorize(policy.Resource{CompanyID: posReturn.CompanyId, BranchID: posReturn.BranchId, StoreID: posReturn.StoreId, OwnerID: posReturn.CreatedBy}); err != nil {
+		return nil, err
+	}
 
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posReturn.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only delete return data within their branch")
-		}
+	// A return booked against a receipt has restocked the items, refunded the tender and counted in the
+	// sales rollups, deleting the row alone would leave all of that on the books
+	if posReturn.PosReceiptId != "" && posReturn.PosReceiptId != uuid.Nil.String() {
+		return nil, status.Error(codes.FailedPrecondition, "returns booked against a receipt cannot be deleted")
 	}
 
 	// Delete the return
@@ -2,19 +2,20 @@ package service
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"os"
+	"log/slog"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
-	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/upstream"
 	"github.com/google/uuid"
-	"github.com/streadway/amqp"
-	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,312 +29,62 @@ type PosSaleService interface {
 
 type posSaleService struct {
 	pb.UnimplementedPosSaleServiceServer
-	saleRepo           repository.PosSaleRepository
-	invoiceRepo        repository.PosInvoiceRepository
-	cashDrawerRepo     repository.PosCashDrawerRepository
-	onlinePyamentRepo  repository.PosOnlinePaymentRepository
-	paymentMethod      repository.PosPaymentMethodRepository
-	customer           repository.PosCustomerRepository
-	RabbitMQConn       *amqp.Connection
-	ProductServiceConn *grpc.ClientConn
-	CompanyServiceConn *grpc.ClientConn
+	saleRepo    repository.PosSaleRepository
+	checkout    *posCheckout
+	idempotency *IdempotencyKeys
+	upstream    *upstream.Client
 }
 
-func NewPosSaleService(saleRepo repository.PosSaleRepository, invoiceRepo repository.PosInvoiceRepository, cashDrawerRepo repository.PosCashDrawerRepository, onlinePyamentRepo repository.PosOnlinePaymentRepository, paymentMethod repository.PosPaymentMethodRepository, customer repository.PosCustomerRepository, rabbitMQConn *amqp.Connection, productServiceConn *grpc.ClientConn, companyServiceConn *grpc.ClientConn) *posSaleService {
+func NewPosSaleService(saleRepo repository.PosSaleRepository, idempotencyKeys *IdempotencyKeys, checkoutRepo repository.PosCheckoutRepository, paymentMethod repository.PosPaymentMethodRepository, customer repository.PosCustomerRepository, taxRate repository.PosTaxRateRepository, promotionRule repository.PosPromotionRuleRepository, drawerSession repository.PosDrawerSessionRepository, payments config.PaymentSettings, upstreamClient *upstream.Client, logger *slog.Logger) *posSaleService {
 	return &posSaleService{
-		saleRepo:           saleRepo,
-		invoiceRepo:        invoiceRepo,
-		cashDrawerRepo:     cashDrawerRepo,
-		onlinePyamentRepo:  onlinePyamentRepo,
-		paymentMethod:      paymentMethod,
-		customer:           customer,
-		RabbitMQConn:       rabbitMQConn,
-		ProductServiceConn: productServiceConn,
-		CompanyServiceConn: companyServiceConn,
+		saleRepo:    saleRepo,
+		checkout:    newPosCheckout(checkoutRepo, paymentMethod, customer, taxRate, promotionRule, drawerSession, payments, upstreamClient, logger),
+		idempotency: idempotencyKeys,
+		upstream:    upstreamClient,
 	}
 }
 
 func (s *posSaleService) CreatePosSales(ctx context.Context, req *pb.CreatePosSalesRequest) (*pb.CreatePosSalesResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	if !utils.IsStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant create sales transactions")
-	}
-
-	var gormSales []*entity.PosSale
-	var getTotalDiscount float64
-	var totalSalesAfterDiscount float64
-
-	var itemList []dto.Items
-	now := timestamppb.New(time.Now())
-	timeStamp := now
-
-	for _, posSale := range req.PosSales {
-		posSale.SaleId = uuid.New().String() // Generate a new UUID for the sale_id
-
-		// set current time stamp
-		posSale.CreatedAt = timeStamp
-		posSale.UpdatedAt = timeStamp
-		posSale.SaleDate = timeStamp
-
-		// get prodict data from Product Service
-		productData, err := utils.GetPosProducByBarcode(s.ProductServiceConn, posSale.ProductId, req.JwtPayload, token)
-		if err != nil {
-			return nil, err
-		}
-
-		// check if product id has promotions
-		promotionData, err := utils.GetPosPromotionByProductId(s.ProductServiceConn, productData.PosProduct.ProductId, req.JwtPayload, req.JwtToken)
-		if err != nil {
-			fmt.Println("Err :", err)
-		}
-
-		// Count total all discount
-		if promotionData == nil {
-			posSale.Price = productData.PosProduct.Price
-		} else {
-			if promotionData.PosPromotion.DiscountRate != 0.0 && promotionData.PosPromotion.Active {
-				countDiscountAmount := productData.PosProduct.Price * promotionData.PosPromotion.DiscountRate
-				getTotalDiscount += countDiscountAmount
-				posSale.Price = productData.PosProduct.Price - countDiscountAmount
-			} else if promotionData.PosPromotion.DiscountRate == 0.0 {
-				posSale.Price = productData.PosProduct.Price
-			}
-		}
-
-		// Count sub total
-		posSale.TotalPrice = posSale.Price * float64(posSale.Quantity)
-
-		// Convert pb.PosSale to entity.PosSale
-		gormSale := &entity.PosSale{
-			SaleID:          uuid.MustParse(posSale.SaleId), // auto
-			ReceiptID:       posSale.ReceiptId,              // auto
-			ProductID:       uuid.MustParse(productData.PosProduct.ProductId),
-			CustomerID:      uuid.MustParse(posSale.CustomerId),
-			Quantity:        int(posSale.Quantity),
-			Price:           posSale.Price,                          // auto
-			SaleDate:        posSale.SaleDate.AsTime(),              // auto
-			TotalPrice:      posSale.TotalPrice,                     // auto
-			StoreID:         uuid.MustParse(req.JwtPayload.StoreId), // auto
-			CashierID:       uuid.MustParse(req.JwtPayload.UserId),  // auto
-			PaymentMethodID: uuid.MustParse(posSale.PaymentMethodId),
-			BranchID:        uuid.MustParse(req.JwtPayload.BranchId),  // auto
-			CompanyID:       uuid.MustParse(req.JwtPayload.CompanyId), // auto
-			CreatedAt:       posSale.CreatedAt.AsTime(),               // auto
-			CreatedBy:       uuid.MustParse(req.JwtPayload.UserId),    // auto
-			UpdatedAt:       posSale.UpdatedAt.AsTime(),               // auto
-			UpdatedBy:       uuid.MustParse(req.JwtPayload.UserId),    // auto
-		}
-
-		item := dto.Items{
-			ProductName: productData.PosProduct.ProductName,
-			Quantity:    int(posSale.Quantity),
-			Price:       posSale.Price,
-			TotalPrice:  posSale.TotalPrice,
-		}
-
-		itemList = append(itemList, item)
-		gormSales = append(gormSales, gormSale)
-
-		// Create record stock out into inventory history
-		inventoryHistory := &dto.PosInventoryHistory{
-			ProductId: gormSale.ProductID.String(),
-			StoreId:   gormSale.StoreID.String(),
-			Quantity:  -int32(gormSale.Quantity),
-			BranchId:  gormSale.BranchID.String(),
+	respond := func(posReceipt *entity.PosReceipt) *pb.CreatePosSalesResponse {
+		return &pb.CreatePosSalesResponse{
+			PosSales:     req.PosSales,
+			CashTendered: money.ToProto(posReceipt.CashTendered),
+			ChangeAmount: money.ToProto(posReceipt.ChangeAmount),
 		}
-
-		_, err = utils.CreatePosInventoryHistory(inventoryHistory, req.JwtPayload, token) // #
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Count total sales
-	subTotalSales := 0.0
-	for _, sale := range gormSales {
-		subTotalSales += sale.TotalPrice
-	}
-
-	// Decrease total sales with discount
-	totalSalesAfterDiscount = subTotalSales - getTotalDiscount
-
-	// insert data into sales database
-	createdPosSales, err := s.saleRepo.CreatePosSales(gormSales, token)
-	if err != nil {
-		return nil, err
-	}
-
-	receiptID := createdPosSales[0].ReceiptID
-	// Get payment method
-	paymentMethodData, err := s.paymentMethod.ReadPosPaymentMethod(gormSales[0].PaymentMethodID.String())
-	if err != nil {
-		return nil, err
 	}
 
-	// If payment method cash --> cash drawer
-	drawerId := uuid.New().String()
-	invoiceId := uuid.New().String()
-	onlinePyamentId := uuid.New().String()
-
-	cashMethod := os.Getenv("CASH_METHOD")
-	payLaterMethod := os.Getenv("PAY_LATER_METHOD")
-
-	if paymentMethodData.MethodName == cashMethod {
-		cashDrawerData := &entity.PosCashDrawer{
-			DrawerID:        uuid.MustParse(drawerId),
-			StoreID:         nil,
-			EmployeeID:      uuid.MustParse(req.JwtPayload.Role),
-			ReceiptID:       receiptID,
-			CashIn:          subTotalSales,
-			Amount:          totalSalesAfterDiscount,
-			CashOut:         0,
-			TransactionTime: now.AsTime(),
-			RoleID:          uuid.MustParse(req.JwtPayload.Role),
-			BranchID:        nil,
-			CompanyID:       uuid.MustParse(req.JwtPayload.CompanyId),
-			Description:     fmt.Sprintf("Sales Receipt ID %s", gormSales[0].ReceiptID),
-			CreatedAt:       now.AsTime(),
-			CreatedBy:       uuid.MustParse(req.JwtPayload.Role),
-			UpdatedAt:       now.AsTime(),
-			UpdatedBy:       uuid.MustParse(req.JwtPayload.Role),
-		}
-		cashDrawerData.StoreID = utils.ParseUUID(req.JwtPayload.StoreId)
-		cashDrawerData.BranchID = utils.ParseUUID(req.JwtPayload.BranchId)
-		err := s.cashDrawerRepo.CreatePosCashDrawer(cashDrawerData)
-		if err != nil {
-			return nil, err
-		}
-		// if payment method pay later
-	} else if paymentMethodData.MethodName == payLaterMethod {
-		invoiceData := &entity.PosInvoice{
-			InvoiceID: uuid.MustParse(invoiceId),
-			ReceiptID: gormSales[0].ReceiptID,
-			Date:      now.AsTime(),
-			Amount:    totalSalesAfterDiscount,
-			Discounts: getTotalDiscount,
-			Taxes:     0,
-			BranchID:  uuid.MustParse(req.JwtPayload.BranchId),
-			CompanyID: uuid.MustParse(req.JwtPayload.CompanyId),
-			CreatedAt: now.AsTime(),
-			CreatedBy: uuid.MustParse(req.JwtPayload.UserId),
-			UpdatedAt: now.AsTime(),
-			UpdatedBy: uuid.MustParse(req.JwtPayload.UserId),
-		}
-		// Create new invoice
-		err := s.invoiceRepo.CreatePosInvoice(invoiceData)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		// if payment method not cash or pay later
-		onlinePaymentData := &entity.PosOnlinePayment{
-			PaymentID:     uuid.MustParse(onlinePyamentId),
-			StoreID:       uuid.MustParse(req.JwtPayload.StoreId),
-			EmployeeID:    uuid.MustParse(req.JwtPayload.UserId),
-			PaymentDate:   now.AsTime(),
-			ReceiptID:     gormSales[0].ReceiptID,
-			Amount:        totalSalesAfterDiscount,
-			PaymentMethod: uuid.MustParse(paymentMethodData.PaymentMethodId),
-			RoleID:        uuid.MustParse(req.JwtPayload.Role),
-			BranchID:      uuid.MustParse(req.JwtPayload.BranchId),
-			CompanyID:     uuid.MustParse(req.JwtPayload.CompanyId),
-			CreatedAt:     now.AsTime(),
-			CreatedBy:     uuid.MustParse(req.JwtPayload.UserId),
-			UpdatedAt:     now.AsTime(),
-			UpdatedBy:     uuid.MustParse(req.JwtPayload.UserId),
-		}
-		err := s.onlinePyamentRepo.CreatePosOnlinePayment(onlinePaymentData)
+	// A retried checkout gets the response of the first one
+	return checkoutOnce(ctx, s.idempotency, req, &pb.CreatePosSalesResponse{}, func(key *entity.PosIdempotencyKey) (*pb.CreatePosSalesResponse, error) {
+		// The checkout fills in the sale and receipt ids of every line
+		posReceipt, err := s.checkout.run(ctx, req, key, func(posReceipt *entity.PosReceipt) proto.Message {
+			return respond(posReceipt)
+		})
 		if err != nil {
 			return nil, err
 		}
-	}
-
-	userData, err := utils.GetPosUserById(s.CompanyServiceConn, req.JwtPayload.UserId, req.JwtPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	storeData, err := utils.GetPosStoreById(s.CompanyServiceConn, req.JwtPayload.StoreId, req.JwtPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	customer, err := s.customer.ReadPosCustomer(gormSales[0].CustomerID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	// Rabbit MQ producer to digital receipt
-	ch, err := s.RabbitMQConn.Channel()
-	if err != nil {
-		return nil, err
-	}
-	defer ch.Close()
-
-	receipt := dto.DigitalReceipt{
-		Receiver: dto.EmailReceiver{
-			EmailAddress: customer.Email,
-		},
-		Header: dto.HeaderReceipt{
-			StoreName:           storeData.PosStore.StoreName,
-			StoreAddress:        storeData.PosStore.Location,
-			CashierName:         userData.PosUser.Username,
-			ReceiptID:           receiptID,
-			TransactionDateTime: timeStamp.String(),
-		},
-		Body: dto.BodyReceipt{
-			Items: itemList,
-		},
-		Summary: dto.SummaryReceipt{
-			SubTotalAmount: subTotalSales,
-			DiscountAmoutn: getTotalDiscount,
-			TaxAmount:      0,
-			TotalAmount:    totalSalesAfterDiscount,
-			CashAmount:     totalSalesAfterDiscount,
-			ChangeAmount:   0,
-		},
-	}
+		return respond(posReceipt), nil
+	})
+}
 
-	err = utils.SendDigitalReceipt(receipt, ch, "email_queue")
+func (s *posSaleService) ReadAllPosSales(ctx context.Context, req *pb.ReadAllPosSalesRequest) (*pb.ReadAllPosSalesResponse, error) {
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.CreatePosSalesResponse{
-		PosSales: req.PosSales,
-	}, nil
-}
-
-func (s *posSaleService) ReadAllPosSales(ctx context.Context, req *pb.ReadAllPosSalesRequest) (*pb.ReadAllPosSalesResponse, error) {
 	pagination := dto.Pagination{
-		Limit: int(req.Limit),
-		Page:  int(req.Page),
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
 	}
 
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read all sales transactions")
-	}
-
-	paginationResult, err := s.saleRepo.ReadAllPosSales(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	paginationResult, err := s.saleRepo.ReadAllPosSales(pagination, access.RoleName, identity.Payload, req.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -348,9 +99,12 @@ func (s *posSaleService) ReadAllPosSales(ctx context.Context, req *pb.ReadAllPos
 			ProductId:       posSale.ProductID.String(),
 			CustomerId:      posSale.CustomerID.String(),
 			Quantity:        int32(posSale.Quantity),
-			Price:           posSale.Price,
+			Price:           money.ToProto(posSale.Price),
 			SaleDate:        timestamppb.New(posSale.SaleDate),
-			TotalPrice:      posSale.TotalPrice,
+			TotalPrice:      money.ToProto(posSale.TotalPrice),
+			TaxAmount:       money.ToProto(posSale.TaxAmount),
+			DiscountAmount:  money.ToProto(posSale.DiscountAmount),
+			PromotionId:     posSale.PromotionID,
 			StoreId:         posSale.StoreID.String(),
 			CashierId:       posSale.CashierID.String(),
 			PaymentMethodId: posSale.PaymentMethodID.String(),
@@ -364,54 +118,29 @@ func (s *posSaleService) ReadAllPosSales(ctx context.Context, req *pb.ReadAllPos
 	}
 
 	return &pb.ReadAllPosSalesResponse{
-		PosSales: pbPosSales,
-		Limit:    int32(pagination.Limit),
-		Page:     int32(pagination.Page),
-		MaxPage:  int32(paginationResult.TotalPages),
-		Count:    paginationResult.TotalRecords,
+		PosSales:       pbPosSales,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
+		NextPageToken:  paginationResult.NextPageToken,
+		CountEstimated: paginationResult.CountEstimated,
 	}, nil
 }
 
 func (s *posSaleService) ReadPosSale(ctx context.Context, req *pb.ReadPosSaleRequest) (*pb.ReadPosSaleResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users cant read sales transactions")
-	}
-
 	posSale, err := s.saleRepo.ReadPosSale(req.SaleId)
 	if err != nil {
 		return nil, err
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posSale.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only retrieve sales data within their company")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posSale.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only retrieve sales data within their branch")
-		}
-	}
-
-	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posSale.StoreId, req.JwtPayload.StoreId) {
-			return nil, errors.New("store users can only retrieve sales data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posSale.CompanyId, BranchID: posSale.BranchId, StoreID: posSale.StoreId, OwnerID: posSale.CashierId}); err != nil {
+		return nil, err
 	}
 
 	return &pb.ReadPosSaleResponse{
@@ -420,18 +149,14 @@ func (s *posSaleService) ReadPosSale(ctx context.Context, req *pb.ReadPosSaleReq
 }
 
 func (s *posSaleService) UpdatePosSale(ctx context.Context, req *pb.UpdatePosSaleRequest) (*pb.UpdatePosSaleResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	identity, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("store users cant update sales data")
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the sale to be updated
@@ -440,12 +165,8 @@ func (s *posSaleService) UpdatePosSale(ctx context.Context, req *pb.UpdatePosSal
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posSale.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only update sales data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posSale.CompanyId, BranchID: posSale.BranchId, StoreID: posSale.StoreId, OwnerID: posSale.CashierId}); err != nil {
+		return nil, err
 	}
 
 	now := timestamppb.New(time.Now())
@@ -457,18 +178,21 @@ func (s *posSaleService) UpdatePosSale(ctx context.Context, req *pb.UpdatePosSal
 		ProductID:       uuid.MustParse(req.PosSale.ProductId),
 		CustomerID:      uuid.MustParse(req.PosSale.CustomerId),
 		Quantity:        int(req.PosSale.Quantity),
-		Price:           posSale.Price, // auto
+		Price:           money.FromProto(posSale.Price), // auto
 		SaleDate:        req.PosSale.SaleDate.AsTime(),
-		TotalPrice:      posSale.Price * float64(req.PosSale.Quantity), // auto
-		StoreID:         uuid.MustParse(req.JwtPayload.StoreId),        // auto
-		CashierID:       uuid.MustParse(req.JwtPayload.UserId),         // auto
+		TotalPrice:      money.FromProto(posSale.Price).Times(int(req.PosSale.Quantity)), // auto
+		TaxAmount:       money.FromProto(posSale.TaxAmount),                              // auto
+		DiscountAmount:  money.FromProto(posSale.DiscountAmount),                         // auto
+		PromotionID:     posSale.PromotionId,                                             // auto
+		StoreID:         uuid.MustParse(identity.Payload.StoreId),                        // auto
+		CashierID:       uuid.MustParse(identity.Payload.UserId),                         // auto
 		PaymentMethodID: uuid.MustParse(posSale.PaymentMethodId),
-		BranchID:        uuid.MustParse(req.JwtPayload.BranchId),
-		CompanyID:       uuid.MustParse(req.JwtPayload.CompanyId),
+		BranchID:        uuid.MustParse(identity.Payload.BranchId),
+		CompanyID:       uuid.MustParse(identity.Payload.CompanyId),
 		CreatedAt:       posSale.CreatedAt.AsTime(),
 		CreatedBy:       uuid.MustParse(posSale.CreatedBy),
 		UpdatedAt:       req.PosSale.UpdatedAt.AsTime(),
-		UpdatedBy:       uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedBy:       uuid.MustParse(identity.Payload.UserId),
 	}
 
 	// Update the sale
@@ -483,32 +207,19 @@ func (s *posSaleService) UpdatePosSale(ctx context.Context, req *pb.UpdatePosSal
 }
 
 func (s *posSaleService) DeletePosSale(ctx context.Context, req *pb.DeletePosSaleRequest) (*pb.DeletePosSaleResponse, error) {
-	// Extract role ID from JWT payload
-	jwtRoleID := req.JwtPayload.Role
-	// token := req.JwtToken
-
-	// Get user login role name
-	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	access, err := policy.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !utils.IsBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("store users cant delete sales data")
-	}
-
 	// Get the sale to be updated
 	posSale, err := s.saleRepo.ReadPosSale(req.SaleId)
 	if err != nil {
 		return nil, err
 	}
 
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posSale.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only delete sales data within their branch")
-		}
+	if err := access.Authorize(policy.Resource{CompanyID: posSale.CompanyId, BranchID: posSale.BranchId, StoreID: posSale.StoreId, OwnerID: posSale.CashierId}); err != nil {
+		return nil, err
 	}
 
 	// Delete the sale
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/utils"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosTaxRateService interface {
+	CreatePosTaxRate(ctx context.Context, req *pb.CreatePosTaxRateRequest) (*pb.CreatePosTaxRateResponse, error)
+	ReadPosTaxRate(ctx context.Context, req *pb.ReadPosTaxRateRequest) (*pb.ReadPosTaxRateResponse, error)
+	UpdatePosTaxRate(ctx context.Context, req *pb.UpdatePosTaxRateRequest) (*pb.UpdatePosTaxRateResponse, error)
+	DeletePosTaxRate(ctx context.Context, req *pb.DeletePosTaxRateRequest) (*pb.DeletePosTaxRateResponse, error)
+	ReadAllPosTaxRates(ctx context.Context, req *pb.ReadAllPosTaxRatesRequest) (*pb.ReadAllPosTaxRatesResponse, error)
+}
+
+type posTaxRateService struct {
+	pb.UnimplementedPosTaxRateServiceServer
+	repo repository.PosTaxRateRepository
+}
+
+func NewPosTaxRateService(repo repository.PosTaxRateRepository) *posTaxRateService {
+	return &posTaxRateService{
+		repo: repo,
+	}
+}
+
+func (s *posTaxRateService) CreatePosTaxRate(ctx context.Context, req *pb.CreatePosTaxRateRequest) (*pb.CreatePosTaxRateResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePosTaxRate(req.PosTaxRate); err != nil {
+		return nil, err
+	}
+
+	req.PosTaxRate.TaxRateId = uuid.New().String() // Generate a new UUID for the tax_rate_id
+	req.PosTaxRate.CompanyId = identity.Payload.CompanyId
+
+	now := timestamppb.New(time.Now())
+	req.PosTaxRate.CreatedAt = now
+	req.PosTaxRate.CreatedBy = identity.Payload.UserId
+	req.PosTaxRate.UpdatedAt = now
+	req.PosTaxRate.UpdatedBy = identity.Payload.UserId
+
+	// Convert pb.PosTaxRate to entity.PosTaxRate
+	gormTaxRate := &entity.PosTaxRate{
+		TaxRateID:    uuid.MustParse(req.PosTaxRate.TaxRateId), // auto
+		TaxName:      req.PosTaxRate.TaxName,
+		Rate:         req.PosTaxRate.Rate,
+		TaxInclusive: req.PosTaxRate.TaxInclusive,
+		CompanyID:    uuid.MustParse(identity.Payload.CompanyId), // auto
+		CreatedAt:    req.PosTaxRate.CreatedAt.AsTime(),          // auto
+		CreatedBy:    uuid.MustParse(identity.Payload.UserId),    // auto
+		UpdatedAt:    req.PosTaxRate.UpdatedAt.AsTime(),          // auto
+		UpdatedBy:    uuid.MustParse(identity.Payload.UserId),    // auto
+	}
+
+	if req.PosTaxRate.StoreId != "" {
+		gormTaxRate.StoreID = utils.ParseUUID(req.PosTaxRate.StoreId)
+	}
+
+	if req.PosTaxRate.CategoryId != "" {
+		gormTaxRate.CategoryID = utils.ParseUUID(req.PosTaxRate.CategoryId)
+	}
+
+	err = s.repo.CreatePosTaxRate(gormTaxRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosTaxRateResponse{
+		PosTaxRate: req.PosTaxRate,
+	}, nil
+}
+
+func (s *posTaxRateService) ReadPosTaxRate(ctx context.Context, req *pb.ReadPosTaxRateRequest) (*pb.ReadPosTaxRateResponse, error) {
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	posTaxRate, err := s.repo.ReadPosTaxRate(req.TaxRateId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posTaxRate.CompanyId}); err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadPosTaxRateResponse{
+		PosTaxRate: posTaxRate,
+	}, nil
+}
+
+func (s *posTaxRateService) UpdatePosTaxRate(ctx context.Context, req *pb.UpdatePosTaxRateRequest) (*pb.UpdatePosTaxRateResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePosTaxRate(req.PosTaxRate); err != nil {
+		return nil, err
+	}
+
+	// Get the tax rate to be updated
+	posTaxRate, err := s.repo.ReadPosTaxRate(req.PosTaxRate.TaxRateId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posTaxRate.CompanyId}); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.New(time.Now())
+	req.PosTaxRate.UpdatedAt = now
+	req.PosTaxRate.UpdatedBy = identity.Payload.UserId
+
+	// Convert pb.PosTaxRate to entity.PosTaxRate
+	gormTaxRate := &entity.PosTaxRate{
+		TaxRateID:    uuid.MustParse(posTaxRate.TaxRateId),
+		TaxName:      req.PosTaxRate.TaxName,
+		Rate:         req.PosTaxRate.Rate,
+		TaxInclusive: req.PosTaxRate.TaxInclusive,
+		CompanyID:    uuid.MustParse(posTaxRate.CompanyId),
+		CreatedAt:    posTaxRate.CreatedAt.AsTime(),
+		CreatedBy:    uuid.MustParse(posTaxRate.CreatedBy),
+		UpdatedAt:    req.PosTaxRate.UpdatedAt.AsTime(),
+		UpdatedBy:    uuid.MustParse(req.PosTaxRate.UpdatedBy),
+	}
+
+	if req.PosTaxRate.StoreId != "" {
+		gormTaxRate.StoreID = utils.ParseUUID(req.PosTaxRate.StoreId)
+	}
+
+	if req.PosTaxRate.CategoryId != "" {
+		gormTaxRate.CategoryID = utils.ParseUUID(req.PosTaxRate.CategoryId)
+	}
+
+	// Update the tax rate
+	posTaxRate, err = s.repo.UpdatePosTaxRate(gormTaxRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosTaxRateResponse{
+		PosTaxRate: posTaxRate,
+	}, nil
+}
+
+func (s *posTaxRateService) DeletePosTaxRate(ctx context.Context, req *pb.DeletePosTaxRateRequest) (*pb.DeletePosTaxRateResponse, error) {
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the tax rate to be deleted
+	posTaxRate, err := s.repo.ReadPosTaxRate(req.TaxRateId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.Authorize(policy.Resource{CompanyID: posTaxRate.CompanyId}); err != nil {
+		return nil, err
+	}
+
+	// Delete the tax rate
+	err = s.repo.DeletePosTaxRate(req.TaxRateId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePosTaxRateResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *posTaxRateService) ReadAllPosTaxRates(ctx context.Context, req *pb.ReadAllPosTaxRatesRequest) (*pb.ReadAllPosTaxRatesResponse, error) {
+	identity, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := dto.Pagination{
+		Limit:         int(req.Limit),
+		Page:          int(req.Page),
+		PageToken:     req.PageToken,
+		EstimateCount: req.EstimateCount,
+	}
+
+	access, err := policy.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paginationResult, err := s.repo.ReadAllPosTaxRates(pagination, access.RoleName, identity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	posTaxRates := paginationResult.Records.([]entity.PosTaxRate)
+	pbPosTaxRates := make([]*pb.PosTaxRate, len(posTaxRates))
+
+	for i := range posTaxRates {
+		pbPosTaxRates[i] = repository.PosTaxRateToProto(&posTaxRates[i])
+	}
+
+	return &pb.ReadAllPosTaxRatesResponse{
+		PosTaxRates:    pbPosTaxRates,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
+		NextPageToken:  paginationResult.NextPageToken,
+		CountEstimated: paginationResult.CountEstimated,
+	}, nil
+}
+
+func validatePosTaxRate(posTaxRate *pb.PosTaxRate) error {
+	if posTaxRate == nil || posTaxRate.TaxName == "" {
+		return errors.New("tax name is required")
+	}
+
+	if posTaxRate.Rate < 0 || posTaxRate.Rate >= 1 {
+		return errors.New("tax rate must be a fraction between 0 and 1")
+	}
+
+	if posTaxRate.StoreId != "" {
+		if _, err := uuid.Parse(posTaxRate.StoreId); err != nil {
+			return errors.New("invalid store id")
+		}
+	}
+
+	if posTaxRate.CategoryId != "" {
+		if _, err := uuid.Parse(posTaxRate.CategoryId); err != nil {
+			return errors.New("invalid category id")
+		}
+	}
+
+	return nil
+}
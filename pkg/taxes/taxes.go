@@ -0,0 +1,106 @@
+// Package taxes picks the tax rate of every sale line and computes its tax.
+// Rates are matched from most to least specific: store and category, category, store, then company wide.
+// Tax inclusive prices already contain the tax, it is backed out of them, tax exclusive prices get it added on top.
+package taxes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+)
+
+// LineTax is the tax of one sale line. TaxRate is nil when no rate applies to the line,
+// Taxable is the line total without its tax.
+type LineTax struct {
+	TaxRate *entity.PosTaxRate
+	Taxable money.Amount
+	Amount  money.Amount
+}
+
+// Engine holds the tax rates of a company
+type Engine struct {
+	rates []entity.PosTaxRate
+}
+
+func NewEngine(rates []entity.PosTaxRate) *Engine {
+	return &Engine{
+		rates: rates,
+	}
+}
+
+// RateFor returns the most specific rate for a store and product category, or nil when none applies
+func (e *Engine) RateFor(storeID string, categoryID string) *entity.PosTaxRate {
+	var match *entity.PosTaxRate
+	bestScore := -1
+
+	for i := range e.rates {
+		rate := &e.rates[i]
+		score := 0
+
+		if rate.StoreID != nil {
+			if rate.StoreID.String() != storeID {
+				continue
+			}
+			score += 1
+		}
+
+		if rate.CategoryID != nil {
+			if rate.CategoryID.String() != categoryID {
+				continue
+			}
+			score += 2
+		}
+
+		if score > bestScore {
+			match = rate
+			bestScore = score
+		}
+	}
+
+	return match
+}
+
+// Compute returns the tax of a line total after discounts.
+// Tax inclusive prices already contain the tax, tax exclusive prices get it added on top.
+func (e *Engine) Compute(storeID string, categoryID string, lineTotal money.Amount) LineTax {
+	rate := e.RateFor(storeID, categoryID)
+	if rate == nil || rate.Rate == 0 {
+		return LineTax{TaxRate: rate, Taxable: lineTotal}
+	}
+
+	if rate.TaxInclusive {
+		amount := lineTotal - lineTotal.DivRate(1+rate.Rate)
+		return LineTax{TaxRate: rate, Taxable: lineTotal - amount, Amount: amount}
+	}
+
+	return LineTax{TaxRate: rate, Taxable: lineTotal, Amount: lineTotal.MulRate(rate.Rate)}
+}
+
+// Breakdown groups the line taxes per tax rate for the receipt
+func Breakdown(lineTaxes []LineTax) []dto.TaxBreakdown {
+	var breakdown []dto.TaxBreakdown
+	index := make(map[string]int)
+
+	for _, tax := range lineTaxes {
+		if tax.TaxRate == nil {
+			continue
+		}
+
+		taxRateID := tax.TaxRate.TaxRateID.String()
+		i, ok := index[taxRateID]
+		if !ok {
+			i = len(breakdown)
+			index[taxRateID] = i
+			breakdown = append(breakdown, dto.TaxBreakdown{
+				TaxName:      tax.TaxRate.TaxName,
+				Rate:         tax.TaxRate.Rate,
+				TaxInclusive: tax.TaxRate.TaxInclusive,
+			})
+		}
+
+		breakdown[i].TaxableAmount += tax.Taxable
+		breakdown[i].TaxAmount += tax.Amount
+	}
+
+	return breakdown
+}
@@ -0,0 +1,51 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// MarshalContext encodes the trace context of ctx so it can be stored with an outbox message.
+// It returns an empty string when ctx carries no trace.
+func MarshalContext(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return ""
+	}
+
+	encoded, err := json.Marshal(carrier)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// UnmarshalContext restores a trace context stored by MarshalContext into ctx
+func UnmarshalContext(ctx context.Context, encoded string) context.Context {
+	if encoded == "" {
+		return ctx
+	}
+
+	carrier := propagation.MapCarrier{}
+	if err := json.Unmarshal([]byte(encoded), &carrier); err != nil {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// AMQPHeaders returns the trace context of ctx as AMQP message headers
+func AMQPHeaders(ctx context.Context) amqp.Table {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	headers := amqp.Table{}
+	for key, value := range carrier {
+		headers[key] = value
+	}
+	return headers
+}
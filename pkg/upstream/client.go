@@ -0,0 +1,139 @@
+package upstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// Client makes every call to the company and product services, over gRPC or HTTP
+type Client struct {
+	company     *endpoint
+	product     *endpoint
+	companyConn *grpc.ClientConn
+	productConn *grpc.ClientConn
+}
+
+// NewClient builds the client from the upstream registry and opens the gRPC connections
+func NewClient(settings config.UpstreamSettings) (*Client, error) {
+	company, err := newEndpoint("company", settings.Company)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := newEndpoint("product", settings.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	companyConn, err := Dial("company", settings.Company)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Company Service gRPC: %w", err)
+	}
+
+	productConn, err := Dial("product", settings.Product)
+	if err != nil {
+		companyConn.Close()
+		return nil, fmt.Errorf("failed to connect to Product Service gRPC: %w", err)
+	}
+
+	return &Client{
+		company:     company,
+		product:     product,
+		companyConn: companyConn,
+		productConn: productConn,
+	}, nil
+}
+
+// CompanyConn returns the gRPC connection to the company service
+func (c *Client) CompanyConn() *grpc.ClientConn {
+	return c.companyConn
+}
+
+// ProductConn returns the gRPC connection to the product service
+func (c *Client) ProductConn() *grpc.ClientConn {
+	return c.productConn
+}
+
+// Close closes both gRPC connections
+func (c *Client) Close() error {
+	companyErr := c.companyConn.Close()
+	productErr := c.productConn.Close()
+	if companyErr != nil {
+		return companyErr
+	}
+	return productErr
+}
+
+// doJSON sends a request carrying the request id of ctx with an optional JSON body and decodes a 200 response into out, when out is not nil.
+// The call is timed under the name of its operation.
+func (e *endpoint) doJSON(ctx context.Context, operation string, method string, path string, token string, body interface{}, out interface{}) (err error) {
+	ctx, span := tracing.Start(ctx, e.name+"."+operation, trace.WithAttributes(attribute.String("upstream.service", e.name)))
+	defer func(start time.Time) {
+		metrics.ObserveUpstreamCall(e.name, operation, start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	url, err := e.url(path)
+	if err != nil {
+		return err
+	}
+
+	var requestBody io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		requestBody = bytes.NewBuffer(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, requestBody)
+	if err != nil {
+		return fmt.Errorf("failed to make HTTP request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	if requestID := logging.RequestID(ctx); requestID != "" {
+		req.Header.Set(logging.REQUEST_ID_HEADER, requestID)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-200 response status: %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return nil
+}
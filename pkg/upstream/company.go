@@ -0,0 +1,87 @@
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+)
+
+// GetPosRoleById reads a role from the company service
+func (c *Client) GetPosRoleById(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosRoleResponse, error) {
+	resp, err := pb.NewPosRoleServiceClient(c.companyConn).ReadPosRole(ctx, &pb.ReadPosRoleRequest{
+		RoleId:     id,
+		JwtPayload: jwtPayload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gRPC method: %w", err)
+	}
+	return resp, nil
+}
+
+// GetPosUserById reads a user from the company service
+func (c *Client) GetPosUserById(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosUserResponse, error) {
+	resp, err := pb.NewPosUserServiceClient(c.companyConn).ReadPosUser(ctx, &pb.ReadPosUserRequest{
+		UserId:     id,
+		JwtPayload: jwtPayload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gRPC method: %w", err)
+	}
+	return resp, nil
+}
+
+// GetPosStoreById reads a store from the company service
+func (c *Client) GetPosStoreById(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosStoreResponse, error) {
+	resp, err := pb.NewPosStoreServiceClient(c.companyConn).ReadPosStore(ctx, &pb.ReadPosStoreRequest{
+		StoreId:    id,
+		JwtPayload: jwtPayload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gRPC method: %w", err)
+	}
+	return resp, nil
+}
+
+// GetNextReceiptID reserves the next receipt number of a store
+func (c *Client) GetNextReceiptID(ctx context.Context, storeID string, token string) (*dto.GetNextReceiptIDApiResponse, error) {
+	var respDto dto.GetNextReceiptIDApiResponse
+	if err := c.company.doJSON(ctx, "GetNextReceiptID", http.MethodGet, fmt.Sprintf("/api/v1/stores/pos_store/%s/next_receipt_id", storeID), token, nil, &respDto); err != nil {
+		return nil, err
+	}
+	return &respDto, nil
+}
+
+// ReleaseReceiptID gives back a receipt number reserved with GetNextReceiptID when the checkout using it fails
+func (c *Client) ReleaseReceiptID(ctx context.Context, storeID string, receiptID string, token string) error {
+	return c.company.doJSON(ctx, "ReleaseReceiptID", http.MethodDelete, fmt.Sprintf("/api/v1/stores/pos_store/%s/receipt_id/%s", storeID, receiptID), token, nil, nil)
+}
+
+// GetPosRole reads a role over the HTTP API of the company service
+func (c *Client) GetPosRole(ctx context.Context, id string, token string) (*dto.RoleApiResponse, error) {
+	var respDto dto.RoleApiResponse
+	if err := c.company.doJSON(ctx, "GetPosRole", http.MethodGet, "/api/v1/roles/pos_role/"+id, token, nil, &respDto); err != nil {
+		return nil, err
+	}
+	return &respDto, nil
+}
+
+// GetPosStore reads a store over the HTTP API of the company service
+func (c *Client) GetPosStore(ctx context.Context, id string, token string) (*dto.PosStoreApiResponse, error) {
+	var respDto dto.PosStoreApiResponse
+	if err := c.company.doJSON(ctx, "GetPosStore", http.MethodGet, "/api/v1/stores/pos_store/"+id, token, nil, &respDto); err != nil {
+		return nil, err
+	}
+	return &respDto, nil
+}
+
+// GetPosUser reads a user over the HTTP API of the company service
+func (c *Client) GetPosUser(ctx context.Context, id string, token string) (*dto.PosUserApiResponse, error) {
+	var respDto dto.PosUserApiResponse
+	if err := c.company.doJSON(ctx, "GetPosUser", http.MethodGet, "/api/v1/users/pos_user/"+id, token, nil, &respDto); err != nil {
+		return nil, err
+	}
+	return &respDto, nil
+}
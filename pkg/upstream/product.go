@@ -0,0 +1,122 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+)
+
+// GetPosProductByBarcode reads a product by its barcode from the product service
+func (c *Client) GetPosProductByBarcode(ctx context.Context, id string, jwtPayload *pb.JWTPayload, token string) (*pb.ReadPosProductByBarcodeResponse, error) {
+	resp, err := pb.NewPosProductServiceClient(c.productConn).ReadPosProductByBarcode(ctx, &pb.ReadPosProductByBarcodeRequest{
+		ProductBarcodeId: id,
+		JwtPayload:       jwtPayload,
+		JwtToken:         token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gRPC method: %w", err)
+	}
+	return resp, nil
+}
+
+// GetPosPromotionByProductId reads the promotion of a product from the product service
+func (c *Client) GetPosPromotionByProductId(ctx context.Context, id string, jwtPayload *pb.JWTPayload, token string) (*pb.ReadPosPromotionByProductIdResponse, error) {
+	resp, err := pb.NewPosPromotionServiceClient(c.productConn).ReadPosPromotionByProductId(ctx, &pb.ReadPosPromotionByProductIdRequest{
+		ProductId:  id,
+		JwtPayload: jwtPayload,
+		JwtToken:   token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gRPC method: %w", err)
+	}
+	return resp, nil
+}
+
+// CreateNewPosInventoryHistory records a stock movement over the gRPC API of the product service
+func (c *Client) CreateNewPosInventoryHistory(ctx context.Context, inventory *pb.PosInventoryHistory, jwtPayload *pb.JWTPayload, token string) (*pb.CreatePosInventoryHistoryResponse, error) {
+	resp, err := pb.NewPosInventoryHistoryServiceClient(c.productConn).CreatePosInventoryHistory(ctx, &pb.CreatePosInventoryHistoryRequest{
+		PosInventoryHistory: inventory,
+		JwtPayload:          jwtPayload,
+		JwtToken:            token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gRPC method: %w", err)
+	}
+	return resp, nil
+}
+
+// CreatePosInventoryHistory records a stock movement over the HTTP API of the product service
+func (c *Client) CreatePosInventoryHistory(ctx context.Context, history *dto.PosInventoryHistory, jwtPayload *pb.JWTPayload, token string) (*dto.PosInventoryHistory, error) {
+	requestBody := dto.CreatePosInventoryHistoryRequest{
+		PosInventoryHistory: history,
+		JwtPayload:          jwtPayload,
+		JwtToken:            token,
+	}
+
+	var successResp dto.CreateInventorySuccessResponse
+	if err := c.product.doJSON(ctx, "CreatePosInventoryHistory", http.MethodPost, "/api/v1/inventory-histories/pos_inventory_history", token, requestBody, &successResp); err != nil {
+		return nil, err
+	}
+
+	if successResp.Data == nil || successResp.Data.PosInventoryHistory == nil {
+		return nil, errors.New("pos inventory history data is missing in the response")
+	}
+
+	return successResp.Data.PosInventoryHistory, nil
+}
+
+// UpdatePosProduct updates a product over the HTTP API of the product service
+func (c *Client) UpdatePosProduct(ctx context.Context, product *dto.PosProduct, jwtPayload *pb.JWTPayload, token string, productId string) (*dto.PosProduct, error) {
+	requestBody := dto.UpdateProductApiRequest{
+		PosProduct: product,
+		JwtPayload: jwtPayload,
+		JwtToken:   token,
+	}
+
+	var successResp dto.UpdateProductApiSuccessResponse
+	if err := c.product.doJSON(ctx, "UpdatePosProduct", http.MethodPut, "/api/v1/products/pos_product/"+productId, token, requestBody, &successResp); err != nil {
+		return nil, err
+	}
+
+	if successResp.Data == nil {
+		return nil, errors.New("pos product data is missing in the response")
+	}
+
+	return successResp.Data, nil
+}
+
+// GetPosProduct reads a product by its barcode over the HTTP API of the product service
+func (c *Client) GetPosProduct(ctx context.Context, id string, token string) (*dto.ReadProductApiResponse, error) {
+	var respDto dto.ReadProductApiResponse
+	if err := c.product.doJSON(ctx, "GetPosProduct", http.MethodGet, "/api/v1/products/pos_product_barcode/"+id, token, nil, &respDto); err != nil {
+		return nil, err
+	}
+	return &respDto, nil
+}
+
+// GetPosPromotion reads a promotion over the HTTP API of the product service
+func (c *Client) GetPosPromotion(ctx context.Context, id string, token string) (*dto.PosPromotion, error) {
+	return c.readPromotion(ctx, "GetPosPromotion", "/api/v1/promotions/pos_promotion/"+id, token)
+}
+
+// GetPosPromotionByProductID reads the promotion of a product over the HTTP API of the product service
+func (c *Client) GetPosPromotionByProductID(ctx context.Context, productID string, token string) (*dto.PosPromotion, error) {
+	return c.readPromotion(ctx, "GetPosPromotionByProductID", "/api/v1/promotions/pos_promotion/by_product/"+productID, token)
+}
+
+func (c *Client) readPromotion(ctx context.Context, operation string, path string, token string) (*dto.PosPromotion, error) {
+	var successResp dto.ReadPromotionApiSuccessResponse
+	if err := c.product.doJSON(ctx, operation, http.MethodGet, path, token, nil, &successResp); err != nil {
+		return nil, err
+	}
+
+	if successResp.Data == nil || successResp.Data.PosPromotion == nil {
+		return nil, errors.New("promotion data is missing in the response")
+	}
+
+	return successResp.Data.PosPromotion, nil
+}
@@ -0,0 +1,117 @@
+// Package upstream is the single client layer for the company and product services.
+// Endpoints come from the upstream registry in the settings, nothing is pinned to localhost.
+package upstream
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/idempotency"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// roundRobinServiceConfig spreads the calls over every address the target resolves to
+const roundRobinServiceConfig = `{"loadBalancingConfig": [{"round_robin": {}}]}`
+
+const httpTimeout = 10 * time.Second
+
+// endpoint is one upstream service with its HTTP base URLs and HTTP client
+type endpoint struct {
+	name     string
+	baseURLs []string
+	next     atomic.Uint64
+	client   *http.Client
+}
+
+func newEndpoint(name string, service config.UpstreamService) (*endpoint, error) {
+	tlsConfig, err := newTLSConfig(service.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tls settings of the %s service: %w", name, err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	// The transport sends the trace context in the request headers and records a client span per request
+	return &endpoint{
+		name:     name,
+		baseURLs: service.BaseURLs,
+		client:   &http.Client{Timeout: httpTimeout, Transport: otelhttp.NewTransport(transport)},
+	}, nil
+}
+
+// url joins the path to the next base URL, base URLs are used round robin
+func (e *endpoint) url(path string) (string, error) {
+	if len(e.baseURLs) == 0 {
+		return "", fmt.Errorf("no base url configured for the %s service", e.name)
+	}
+
+	i := (e.next.Add(1) - 1) % uint64(len(e.baseURLs))
+	return strings.TrimRight(e.baseURLs[i], "/") + path, nil
+}
+
+// Dial opens a gRPC connection to an upstream service.
+// The target is resolved with DNS unless it names another resolver, and calls are balanced round robin.
+// Every call sends the bearer token, request id and trace context of its context in the metadata
+// and is timed under the service name.
+func Dial(name string, service config.UpstreamService) (*grpc.ClientConn, error) {
+	if service.GrpcTarget == "" {
+		return nil, errors.New("grpc target is not configured")
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if service.TLS.Enabled {
+		tlsConfig, err := newTLSConfig(service.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+
+	return grpc.NewClient(service.GrpcTarget,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultServiceConfig(roundRobinServiceConfig),
+		grpc.WithChainUnaryInterceptor(auth.UnaryClientInterceptor(), logging.UnaryClientInterceptor(), idempotency.UnaryClientInterceptor(), metrics.UnaryClientInterceptor(name)),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+}
+
+// newTLSConfig builds the client TLS config, a CA file replaces the system roots
+func newTLSConfig(settings config.TLSSettings) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         settings.ServerName,
+		InsecureSkipVerify: settings.InsecureSkipVerify,
+	}
+
+	if settings.CAFile != "" {
+		caPEM, err := os.ReadFile(settings.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca file: %w", err)
+		}
+
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("ca file %s has no certificates", settings.CAFile)
+		}
+		tlsConfig.RootCAs = roots
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,210 @@
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"sync"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ROLE_CACHE_KEY_PREFIX prefixes the Redis keys of the cached roles
+const ROLE_CACHE_KEY_PREFIX = "pos_role:"
+
+// RoleLookup reads a role from its source, Client.GetPosRoleById in production
+type RoleLookup func(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosRoleResponse, error)
+
+// RoleCache resolves role ids for the permission checks without calling the company service on every request.
+// Roles are kept in memory and in Redis, shared by every replica, and refreshed after the TTL of the settings.
+// A failing company service is retried with backoff within the deadline of the request,
+// when it stays unreachable the last known role is served until it is older than the stale TTL.
+type RoleCache struct {
+	lookup   RoleLookup
+	redis    *redis.Client
+	settings config.RoleCacheSettings
+	logger   *slog.Logger
+
+	mu      sync.RWMutex
+	entries map[string]cachedRole
+}
+
+type cachedRole struct {
+	RoleID    string    `json:"role_id"`
+	RoleName  string    `json:"role_name"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// NewRoleCache caches the roles read by lookup, redisClient may be nil to keep them in memory only
+func NewRoleCache(lookup RoleLookup, redisClient *redis.Client, settings config.RoleCacheSettings, logger *slog.Logger) *RoleCache {
+	return &RoleCache{
+		lookup:   lookup,
+		redis:    redisClient,
+		settings: settings,
+		logger:   logger,
+		entries:  map[string]cachedRole{},
+	}
+}
+
+// GetPosRoleById returns the role from memory, Redis or the company service, in that order
+func (c *RoleCache) GetPosRoleById(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosRoleResponse, error) {
+	cached, found := c.fromMemory(id)
+	if found && c.fresh(cached) {
+		metrics.ObserveRoleLookup("memory")
+		return cached.response(), nil
+	}
+
+	if fromRedis, ok := c.fromRedis(ctx, id); ok && (!found || fromRedis.FetchedAt.After(cached.FetchedAt)) {
+		cached, found = fromRedis, true
+		c.remember(cached)
+		if c.fresh(cached) {
+			metrics.ObserveRoleLookup("redis")
+			return cached.response(), nil
+		}
+	}
+
+	resp, err := c.fetch(ctx, id, jwtPayload)
+	if err == nil {
+		role := cachedRole{RoleID: id, RoleName: resp.GetPosRole().GetRoleName(), FetchedAt: time.Now()}
+		c.remember(role)
+		c.store(ctx, role)
+		metrics.ObserveRoleLookup("upstream")
+		return resp, nil
+	}
+
+	if found && retryable(err) && time.Since(cached.FetchedAt) < c.settings.StaleTTL {
+		c.logger.WarnContext(ctx, "company service unavailable, serving cached role",
+			slog.String("role_id", id),
+			slog.Duration("age", time.Since(cached.FetchedAt)),
+			slog.Any("error", err),
+		)
+		metrics.ObserveRoleLookup("stale")
+		return cached.response(), nil
+	}
+
+	metrics.ObserveRoleLookup("failed")
+	return nil, err
+}
+
+// fetch calls the company service, every attempt is bounded by the lookup timeout and the deadline of ctx
+func (c *RoleCache) fetch(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosRoleResponse, error) {
+	backoff := c.settings.Backoff
+
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, c.settings.Timeout)
+		resp, err := c.lookup(attemptCtx, id, jwtPayload)
+		cancel()
+		if err == nil {
+			return resp, nil
+		}
+
+		if attempt >= c.settings.Attempts || !retryable(err) || ctx.Err() != nil {
+			return nil, err
+		}
+
+		// Jitter keeps the replicas from retrying in step
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return nil, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+	}
+}
+
+// retryable reports whether the company service may answer a later attempt, a missing role is not retried
+func retryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *RoleCache) fresh(role cachedRole) bool {
+	return time.Since(role.FetchedAt) < c.settings.TTL
+}
+
+func (c *RoleCache) fromMemory(id string) (cachedRole, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	role, ok := c.entries[id]
+	return role, ok
+}
+
+func (c *RoleCache) remember(role cachedRole) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[role.RoleID] = role
+}
+
+// fromRedis reads the role other replicas cached, a failing Redis only costs a call to the company service
+func (c *RoleCache) fromRedis(ctx context.Context, id string) (cachedRole, bool) {
+	if c.redis == nil {
+		return cachedRole{}, false
+	}
+
+	data, err := c.redis.Get(ctx, ROLE_CACHE_KEY_PREFIX+id).Bytes()
+	metrics.ObserveCacheLookup("role", err)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.logger.WarnContext(ctx, "failed to read cached role", slog.String("role_id", id), slog.Any("error", err))
+		}
+		return cachedRole{}, false
+	}
+
+	var role cachedRole
+	if err := json.Unmarshal(data, &role); err != nil || role.RoleID != id {
+		return cachedRole{}, false
+	}
+	return role, true
+}
+
+// store keeps the role in Redis for the stale TTL so every replica can still serve it while the company service is down
+func (c *RoleCache) store(ctx context.Context, role cachedRole) {
+	if c.redis == nil {
+		return
+	}
+
+	data, err := json.Marshal(role)
+	if err != nil {
+		return
+	}
+
+	if err := c.redis.Set(ctx, ROLE_CACHE_KEY_PREFIX+role.RoleID, data, c.settings.StaleTTL).Err(); err != nil {
+		c.logger.WarnContext(ctx, "failed to cache role", slog.String("role_id", role.RoleID), slog.Any("error", err))
+	}
+}
+
+func (r cachedRole) response() *pb.ReadPosRoleResponse {
+	return &pb.ReadPosRoleResponse{
+		PosRole: &pb.PosRole{
+			RoleId:   r.RoleID,
+			RoleName: r.RoleName,
+		},
+	}
+}
@@ -3,15 +3,16 @@ package routes
 import (
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 
 	"github.com/gin-gonic/gin"
 )
 
-func PosCashDrawerRoutes(r *gin.Engine, posCashDrawerController controller.PosCashDrawerController) {
+func PosCashDrawerRoutes(r *gin.Engine, posCashDrawerController controller.PosCashDrawerController, auth config.AuthSettings) {
 	routes := r.Group("/api")
 
 	// Apply the JWT middleware to all routes in this group
-	routes.Use(midlleware.JWTAuthMiddleware())
+	routes.Use(midlleware.JWTAuthMiddleware(auth))
 
 	routesV1 := routes.Group("/v1/cash-drawers")
 	// Create New PosCashDrawer
@@ -24,4 +25,10 @@ func PosCashDrawerRoutes(r *gin.Engine, posCashDrawerController controller.PosCa
 	routesV1.DELETE("/pos_cash_drawer/:id", posCashDrawerController.HandleDeletePosCashDrawerRequest)
 	// Get All PosCashDrawers
 	routesV1.GET("/pos_cash_drawers", posCashDrawerController.HandleReadAllPosCashDrawersRequest)
+	// Open a drawer session for the logged in cashier
+	routesV1.POST("/pos_drawer_session", posCashDrawerController.HandleOpenDrawerSessionRequest)
+	// Close a drawer session with its blind count
+	routesV1.PUT("/pos_drawer_session/:id/close", posCashDrawerController.HandleCloseDrawerSessionRequest)
+	// Get All drawer sessions with their variances
+	routesV1.GET("/pos_drawer_sessions", posCashDrawerController.HandleReadAllDrawerSessionsRequest)
 }
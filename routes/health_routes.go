@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
+	"github.com/gin-gonic/gin"
+)
+
+// HealthRoutes are left out of the JWT middleware, orchestrators call them without a token
+func HealthRoutes(r *gin.Engine, healthController controller.HealthController) {
+	// Liveness of the gateway process
+	r.GET("/healthz", healthController.HandleLivenessRequest)
+	// Readiness of the gateway and the backend behind it
+	r.GET("/readyz", healthController.HandleReadinessRequest)
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsRoutes serves the Prometheus metrics of the gateway, Prometheus scrapes them without a token
+func MetricsRoutes(r *gin.Engine) {
+	r.GET("/metrics", metrics.GinHandler())
+}
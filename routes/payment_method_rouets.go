@@ -3,14 +3,15 @@ package routes
 import (
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/gin-gonic/gin"
 )
 
-func PosPaymentMethodRoutes(r *gin.Engine, posPaymentMethodController controller.PosPaymentMethodController) {
+func PosPaymentMethodRoutes(r *gin.Engine, posPaymentMethodController controller.PosPaymentMethodController, auth config.AuthSettings) {
 	routes := r.Group("/api")
 
 	// Apply the JWT middleware to all routes in this group
-	routes.Use(midlleware.JWTAuthMiddleware())
+	routes.Use(midlleware.JWTAuthMiddleware(auth))
 
 	routesV1 := routes.Group("/v1/payment-methods")
 	// Create New PosPaymentMethod
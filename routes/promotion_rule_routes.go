@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/gin-gonic/gin"
+)
+
+func PosPromotionRuleRoutes(r *gin.Engine, posPromotionRuleController controller.PosPromotionRuleController, auth config.AuthSettings) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware(auth))
+
+	routesV1 := routes.Group("/v1/promotion-rules")
+	// Create New PosPromotionRule
+	routesV1.POST("/pos_promotion_rule", posPromotionRuleController.HandleCreatePosPromotionRuleRequest)
+	// Get PosPromotionRule by ID
+	routesV1.GET("/pos_promotion_rule/:id", posPromotionRuleController.HandleReadPosPromotionRuleRequest)
+	// Update Existing PosPromotionRule
+	routesV1.PUT("/pos_promotion_rule/:id", posPromotionRuleController.HandleUpdatePosPromotionRuleRequest)
+	// Delete PosPromotionRule
+	routesV1.DELETE("/pos_promotion_rule/:id", posPromotionRuleController.HandleDeletePosPromotionRuleRequest)
+	// Get All PosPromotionRules
+	routesV1.GET("/pos_promotion_rules", posPromotionRuleController.HandleReadAllPosPromotionRulesRequest)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/gin-gonic/gin"
+)
+
+func PosReceiptRoutes(r *gin.Engine, posReceiptController controller.PosReceiptController, auth config.AuthSettings) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware(auth))
+
+	routesV1 := routes.Group("/v1/sales")
+	// Create New PosReceipt
+	routesV1.POST("/pos_receipt", posReceiptController.HandleCreatePosReceiptRequest)
+	// Get PosReceipt by ID
+	routesV1.GET("/pos_receipt/:id", posReceiptController.HandleReadPosReceiptRequest)
+	// Void Existing PosReceipt
+	routesV1.PUT("/pos_receipt/:id/void", posReceiptController.HandleVoidPosReceiptRequest)
+	// Get All PosReceipts
+	routesV1.GET("/pos_receipts", posReceiptController.HandleReadAllPosReceiptsRequest)
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/gin-gonic/gin"
+)
+
+func PosReportRoutes(r *gin.Engine, posReportController controller.PosReportController, auth config.AuthSettings) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware(auth))
+
+	routesV1 := routes.Group("/v1/reports")
+	// Get the daily summary (Z-report) of a store
+	routesV1.GET("/stores/:store_id/daily_summary", posReportController.HandleGetDailyStoreSummaryRequest)
+	// Get the sales time series of the company or branch, for the dashboards
+	routesV1.GET("/sales_series", posReportController.HandleGetSalesTimeSeriesRequest)
+}
@@ -3,14 +3,15 @@ package routes
 import (
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
 	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
 	"github.com/gin-gonic/gin"
 )
 
-func PosReturnRoutes(r *gin.Engine, posReturnController controller.PosReturnController) {
+func PosReturnRoutes(r *gin.Engine, posReturnController controller.PosReturnController, auth config.AuthSettings) {
 	routes := r.Group("/api")
 
 	// Apply the JWT middleware to all routes in this group
-	routes.Use(midlleware.JWTAuthMiddleware())
+	routes.Use(midlleware.JWTAuthMiddleware(auth))
 
 	routesV1 := routes.Group("/v1/returns")
 	// Create New PosReturn
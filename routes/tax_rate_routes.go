@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/api/midlleware"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/gin-gonic/gin"
+)
+
+func PosTaxRateRoutes(r *gin.Engine, posTaxRateController controller.PosTaxRateController, auth config.AuthSettings) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware(auth))
+
+	routesV1 := routes.Group("/v1/tax-rates")
+	// Create New PosTaxRate
+	routesV1.POST("/pos_tax_rate", posTaxRateController.HandleCreatePosTaxRateRequest)
+	// Get PosTaxRate by ID
+	routesV1.GET("/pos_tax_rate/:id", posTaxRateController.HandleReadPosTaxRateRequest)
+	// Update Existing PosTaxRate
+	routesV1.PUT("/pos_tax_rate/:id", posTaxRateController.HandleUpdatePosTaxRateRequest)
+	// Delete PosTaxRate
+	routesV1.DELETE("/pos_tax_rate/:id", posTaxRateController.HandleDeletePosTaxRateRequest)
+	// Get All PosTaxRates
+	routesV1.GET("/pos_tax_rates", posTaxRateController.HandleReadAllPosTaxRatesRequest)
+}
@@ -12,9 +12,57 @@ CREATE TABLE pos_cash_drawer (
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     created_by UUID,
     updated_at TIMESTAMP,
+    updated_by UUID,
+    session_id UUID
+);
+
+CREATE INDEX idx_pos_cash_drawer_session_id ON pos_cash_drawer (session_id);
+CREATE INDEX idx_pos_cash_drawer_company_id_created_at ON pos_cash_drawer (company_id, created_at, drawer_id);
+CREATE INDEX idx_pos_cash_drawer_company_id_transaction_time ON pos_cash_drawer (company_id, transaction_time, drawer_id);
+CREATE INDEX idx_pos_cash_drawer_branch_id_created_at ON pos_cash_drawer (branch_id, created_at, drawer_id);
+CREATE INDEX idx_pos_cash_drawer_branch_id_transaction_time ON pos_cash_drawer (branch_id, transaction_time, drawer_id);
+CREATE INDEX idx_pos_cash_drawer_store_id_created_at ON pos_cash_drawer (store_id, created_at, drawer_id);
+CREATE INDEX idx_pos_cash_drawer_store_id_transaction_time ON pos_cash_drawer (store_id, transaction_time, drawer_id);
+
+CREATE TABLE pos_drawer_sessions (
+    session_id UUID PRIMARY KEY,
+    store_id UUID NOT NULL,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    cashier_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    opening_float DECIMAL(10, 2) NOT NULL,
+    expected_cash DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    counted_cash DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    variance DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    opened_at TIMESTAMP NOT NULL,
+    closed_at TIMESTAMP,
+    closed_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
     updated_by UUID
 );
 
+CREATE INDEX idx_pos_drawer_sessions_company_id_created_at ON pos_drawer_sessions (company_id, created_at, session_id);
+CREATE INDEX idx_pos_drawer_sessions_company_id_opened_at ON pos_drawer_sessions (company_id, opened_at, session_id);
+CREATE INDEX idx_pos_drawer_sessions_branch_id_created_at ON pos_drawer_sessions (branch_id, created_at, session_id);
+CREATE INDEX idx_pos_drawer_sessions_branch_id_opened_at ON pos_drawer_sessions (branch_id, opened_at, session_id);
+
+-- A cashier can only have one open session per store
+CREATE UNIQUE INDEX idx_pos_drawer_sessions_open ON pos_drawer_sessions (store_id, cashier_id) WHERE status = 'OPEN';
+CREATE INDEX idx_pos_drawer_sessions_branch_id ON pos_drawer_sessions (branch_id);
+
+CREATE TABLE pos_drawer_session_denominations (
+    denomination_id UUID PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES pos_drawer_sessions(session_id),
+    denomination DECIMAL(10, 2) NOT NULL,
+    count INT NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL
+);
+
+CREATE INDEX idx_pos_drawer_session_denominations_session_id ON pos_drawer_session_denominations (session_id);
+
 CREATE TABLE pos_customers (
     customer_id UUID PRIMARY KEY,
     first_name VARCHAR(255) NOT NULL,
@@ -34,6 +82,11 @@ CREATE TABLE pos_customers (
     updated_by UUID
 );
 
+CREATE INDEX idx_pos_customers_company_id_created_at ON pos_customers (company_id, created_at, customer_id);
+CREATE INDEX idx_pos_customers_company_id_registration_date ON pos_customers (company_id, registration_date, customer_id);
+CREATE INDEX idx_pos_customers_branch_id_created_at ON pos_customers (branch_id, created_at, customer_id);
+CREATE INDEX idx_pos_customers_branch_id_registration_date ON pos_customers (branch_id, registration_date, customer_id);
+
 CREATE TABLE pos_invoices (
     invoice_id UUID PRIMARY KEY,
     sale_id UUID REFERENCES pos_sales(sale_id),
@@ -49,6 +102,11 @@ CREATE TABLE pos_invoices (
     updated_by UUID
 );
 
+CREATE INDEX idx_pos_invoices_company_id_created_at ON pos_invoices (company_id, created_at, invoice_id);
+CREATE INDEX idx_pos_invoices_company_id_date ON pos_invoices (company_id, "date", invoice_id);
+CREATE INDEX idx_pos_invoices_branch_id_created_at ON pos_invoices (branch_id, created_at, invoice_id);
+CREATE INDEX idx_pos_invoices_branch_id_date ON pos_invoices (branch_id, "date", invoice_id);
+
 CREATE TABLE pos_online_payments (
     payment_id UUID PRIMARY KEY,
     store_id UUID,
@@ -66,6 +124,13 @@ CREATE TABLE pos_online_payments (
     updated_by UUID
 );
 
+CREATE INDEX idx_pos_online_payments_company_id_created_at ON pos_online_payments (company_id, created_at, payment_id);
+CREATE INDEX idx_pos_online_payments_company_id_payment_date ON pos_online_payments (company_id, payment_date, payment_id);
+CREATE INDEX idx_pos_online_payments_branch_id_created_at ON pos_online_payments (branch_id, created_at, payment_id);
+CREATE INDEX idx_pos_online_payments_branch_id_payment_date ON pos_online_payments (branch_id, payment_date, payment_id);
+CREATE INDEX idx_pos_online_payments_store_id_created_at ON pos_online_payments (store_id, created_at, payment_id);
+CREATE INDEX idx_pos_online_payments_store_id_payment_date ON pos_online_payments (store_id, payment_date, payment_id);
+
 CREATE TABLE pos_payment_methods (
     payment_method_id UUID PRIMARY KEY,
     method_name VARCHAR(255) NOT NULL,
@@ -76,13 +141,23 @@ CREATE TABLE pos_payment_methods (
     updated_by UUID
 );
 
+CREATE INDEX idx_pos_payment_methods_company_id_created_at ON pos_payment_methods (company_id, created_at, payment_method_id);
+
 CREATE TABLE pos_returns (
     return_id UUID PRIMARY KEY,
+    receipt_id VARCHAR(255) NOT NULL,
+    pos_receipt_id UUID,
     sale_id UUID REFERENCES pos_sales(sale_id),
+    payment_method_id UUID,
+    tender_id UUID,
     product_id UUID NOT NULL,
     quantity INT NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL,
+    tax_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
     return_date TIMESTAMP NOT NULL,
     reason TEXT,
+    store_id UUID,
     branch_id UUID NOT NULL,
     company_id UUID NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
@@ -91,6 +166,16 @@ CREATE TABLE pos_returns (
     updated_by UUID
 );
 
+CREATE INDEX idx_pos_returns_pos_receipt_id ON pos_returns (pos_receipt_id);
+CREATE INDEX idx_pos_returns_sale_id ON pos_returns (sale_id);
+CREATE INDEX idx_pos_returns_tender_id ON pos_returns (tender_id);
+CREATE INDEX idx_pos_returns_company_id_created_at ON pos_returns (company_id, created_at, return_id);
+CREATE INDEX idx_pos_returns_company_id_return_date ON pos_returns (company_id, return_date, return_id);
+CREATE INDEX idx_pos_returns_branch_id_created_at ON pos_returns (branch_id, created_at, return_id);
+CREATE INDEX idx_pos_returns_branch_id_return_date ON pos_returns (branch_id, return_date, return_id);
+CREATE INDEX idx_pos_returns_store_id_created_at ON pos_returns (store_id, created_at, return_id);
+CREATE INDEX idx_pos_returns_store_id_return_date ON pos_returns (store_id, return_date, return_id);
+
 CREATE TABLE pos_sales (
     sale_id UUID PRIMARY KEY,
     receipt_id VARCHAR(255) NOT NULL,
@@ -100,6 +185,9 @@ CREATE TABLE pos_sales (
     price DECIMAL(10, 2) NOT NULL,
     sale_date TIMESTAMP NOT NULL,
     total_price DECIMAL(10, 2) NOT NULL,
+    tax_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    discount_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    promotion_id VARCHAR(255),
     store_id UUID,
     cashier_id UUID,
     payment_method_id UUID NOT NULL,
@@ -111,6 +199,176 @@ CREATE TABLE pos_sales (
     updated_by UUID
 );
 
+CREATE INDEX idx_pos_sales_company_id_created_at ON pos_sales (company_id, created_at, sale_id);
+CREATE INDEX idx_pos_sales_company_id_sale_date ON pos_sales (company_id, sale_date, sale_id);
+CREATE INDEX idx_pos_sales_branch_id_created_at ON pos_sales (branch_id, created_at, sale_id);
+CREATE INDEX idx_pos_sales_branch_id_sale_date ON pos_sales (branch_id, sale_date, sale_id);
+CREATE INDEX idx_pos_sales_store_id_created_at ON pos_sales (store_id, created_at, sale_id);
+CREATE INDEX idx_pos_sales_store_id_sale_date ON pos_sales (store_id, sale_date, sale_id);
+
+CREATE TABLE pos_receipts (
+    pos_receipt_id UUID PRIMARY KEY,
+    receipt_id VARCHAR(255) NOT NULL,
+    store_id UUID NOT NULL,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    cashier_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    sub_total DECIMAL(10, 2) NOT NULL,
+    discount_total DECIMAL(10, 2) NOT NULL,
+    tax_total DECIMAL(10, 2) NOT NULL,
+    total DECIMAL(10, 2) NOT NULL,
+    cash_tendered DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    change_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    currency_code VARCHAR(3),
+    receipt_promotion_id VARCHAR(255),
+    receipt_date TIMESTAMP NOT NULL,
+    void_reason TEXT,
+    voided_at TIMESTAMP,
+    voided_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE INDEX idx_pos_receipts_receipt_id ON pos_receipts (receipt_id);
+CREATE INDEX idx_pos_receipts_company_id_created_at ON pos_receipts (company_id, created_at, pos_receipt_id);
+CREATE INDEX idx_pos_receipts_company_id_receipt_date ON pos_receipts (company_id, receipt_date, pos_receipt_id);
+CREATE INDEX idx_pos_receipts_branch_id_created_at ON pos_receipts (branch_id, created_at, pos_receipt_id);
+CREATE INDEX idx_pos_receipts_branch_id_receipt_date ON pos_receipts (branch_id, receipt_date, pos_receipt_id);
+CREATE INDEX idx_pos_receipts_store_id_created_at ON pos_receipts (store_id, created_at, pos_receipt_id);
+CREATE INDEX idx_pos_receipts_store_id_receipt_date ON pos_receipts (store_id, receipt_date, pos_receipt_id);
+
+CREATE TABLE pos_receipt_lines (
+    line_id UUID PRIMARY KEY,
+    pos_receipt_id UUID NOT NULL REFERENCES pos_receipts(pos_receipt_id),
+    sale_id UUID NOT NULL REFERENCES pos_sales(sale_id),
+    product_id UUID NOT NULL,
+    product_name VARCHAR(255),
+    quantity INT NOT NULL,
+    unit_price DECIMAL(10, 2) NOT NULL,
+    discount_amount DECIMAL(10, 2) NOT NULL,
+    total_price DECIMAL(10, 2) NOT NULL,
+    tax_rate_id UUID,
+    tax_rate DECIMAL(6, 4) NOT NULL DEFAULT 0,
+    tax_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    promotion_id VARCHAR(255),
+    tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_pos_receipt_lines_pos_receipt_id ON pos_receipt_lines (pos_receipt_id);
+
+CREATE TABLE pos_receipt_tenders (
+    tender_id UUID PRIMARY KEY,
+    pos_receipt_id UUID NOT NULL REFERENCES pos_receipts(pos_receipt_id),
+    payment_method_id UUID NOT NULL REFERENCES pos_payment_methods(payment_method_id),
+    payment_method_name VARCHAR(255),
+    amount DECIMAL(10, 2) NOT NULL,
+    cash_tendered DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    change_amount DECIMAL(10, 2) NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_pos_receipt_tenders_pos_receipt_id ON pos_receipt_tenders (pos_receipt_id);
+
+CREATE TABLE pos_tax_rates (
+    tax_rate_id UUID PRIMARY KEY,
+    tax_name VARCHAR(255) NOT NULL,
+    rate DECIMAL(6, 4) NOT NULL,
+    tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    store_id UUID,
+    category_id UUID,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE INDEX idx_pos_tax_rates_company_id ON pos_tax_rates (company_id);
+CREATE INDEX idx_pos_tax_rates_company_id_created_at ON pos_tax_rates (company_id, created_at, tax_rate_id);
+
+CREATE TABLE pos_promotion_rules (
+    promotion_rule_id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    rule_type VARCHAR(30) NOT NULL,
+    product_ids TEXT,
+    discount_rate DECIMAL(6, 4) NOT NULL DEFAULT 0,
+    discount_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    buy_quantity INT NOT NULL DEFAULT 0,
+    get_quantity INT NOT NULL DEFAULT 0,
+    bundle_price DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    min_subtotal DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    store_id UUID,
+    branch_id UUID,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE INDEX idx_pos_promotion_rules_company_id ON pos_promotion_rules (company_id);
+CREATE INDEX idx_pos_promotion_rules_company_id_created_at ON pos_promotion_rules (company_id, created_at, promotion_rule_id);
+
+
+CREATE TABLE pos_outbox_messages (
+    message_id UUID PRIMARY KEY,
+    aggregate_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    routing_key VARCHAR(255) NOT NULL,
+    payload TEXT NOT NULL,
+    trace_context TEXT,
+    status VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL,
+    published_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX idx_pos_outbox_messages_pending ON pos_outbox_messages (status, next_attempt_at);
+
+-- An idempotency key is scoped to the user who sent it, a retry with the same key gets the first response
+CREATE TABLE pos_idempotency_keys (
+    user_id UUID NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    method VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    response BYTEA,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    PRIMARY KEY (user_id, idempotency_key)
+);
+
+CREATE INDEX idx_pos_idempotency_keys_expires_at ON pos_idempotency_keys (expires_at);
+
+-- Sales of a store summed per UTC hour and payment method, the reports read these instead of the receipts
+CREATE TABLE pos_sales_rollups (
+    company_id UUID NOT NULL,
+    branch_id UUID NOT NULL,
+    bucket_start TIMESTAMP NOT NULL,
+    store_id UUID NOT NULL,
+    payment_method_id UUID NOT NULL,
+    gross_sales DECIMAL(18, 2) NOT NULL DEFAULT 0,
+    discounts DECIMAL(18, 2) NOT NULL DEFAULT 0,
+    taxes DECIMAL(18, 2) NOT NULL DEFAULT 0,
+    returns DECIMAL(18, 2) NOT NULL DEFAULT 0,
+    return_taxes DECIMAL(18, 2) NOT NULL DEFAULT 0,
+    transactions INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP,
+    PRIMARY KEY (bucket_start, store_id, payment_method_id)
+);
+
+CREATE INDEX idx_pos_sales_rollups_company_bucket ON pos_sales_rollups (company_id, bucket_start);
+CREATE INDEX idx_pos_sales_rollups_branch_bucket ON pos_sales_rollups (branch_id, bucket_start);
 
 
 -- Memasukkan data ke dalam pos_customers
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  grpc.UnaryHandler
+		wantCode codes.Code
+		wantResp interface{}
+	}{
+		{
+			"handler answers",
+			func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil },
+			codes.OK, "ok",
+		},
+		{
+			"handler fails",
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, status.Error(codes.InvalidArgument, "bad request")
+			},
+			codes.InvalidArgument, nil,
+		},
+		{
+			"handler panics",
+			func(ctx context.Context, req interface{}) (interface{}, error) { panic("invalid UUID length: 0") },
+			codes.Internal, nil,
+		},
+		{
+			"handler panics with an error",
+			func(ctx context.Context, req interface{}) (interface{}, error) { panic(errors.New("nil map")) },
+			codes.Internal, nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := logging.RecoveryUnaryServerInterceptor(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/pos.PosReceiptService/CreatePosReceipt"}, tt.handler)
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v (err %v)", code, tt.wantCode, err)
+			}
+			if resp != tt.wantResp {
+				t.Errorf("interceptor response = %v, want %v", resp, tt.wantResp)
+			}
+		})
+	}
+}
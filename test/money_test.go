@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+)
+
+func TestMoneyFromFloat(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+		want  money.Amount
+	}{
+		{"whole amount", 10, 1000},
+		{"two decimals", 10.55, 1055},
+		{"rounds half away from zero", 0.125, 13},
+		{"rounds down below half", 0.124, 12},
+		{"negative rounds half away from zero", -0.125, -13},
+		{"zero", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := money.FromFloat(tt.value); got != tt.want {
+				t.Errorf("FromFloat(%v) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    money.Amount
+		wantErr bool
+	}{
+		{"whole amount", "10", 1000, false},
+		{"one decimal", "10.5", 1050, false},
+		{"two decimals", "10.55", 1055, false},
+		{"negative", "-3.25", -325, false},
+		{"plus sign", "+3.25", 325, false},
+		{"no whole part", ".5", 50, false},
+		{"no fraction", "5.", 500, false},
+		{"surrounding spaces", " 7.10 ", 710, false},
+		{"third decimal rounds up at half", "1.005", 101, false},
+		{"third decimal rounds down below half", "1.004", 100, false},
+		{"negative rounds half away from zero", "-1.005", -101, false},
+		{"rounding carries into the whole part", "0.995", 100, false},
+		{"empty", "", 0, true},
+		{"sign only", "-", 0, true},
+		{"point only", ".", 0, true},
+		{"repeated minus", "--5", 0, true},
+		{"minus then plus", "-+5", 0, true},
+		{"plus then minus", "+-5", 0, true},
+		{"sign after the point", "5.-5", 0, true},
+		{"two points", "1.2.3", 0, true},
+		{"exponent", "1e3", 0, true},
+		{"letters", "abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := money.Parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		amount money.Amount
+		want   string
+	}{
+		{1050, "10.50"},
+		{5, "0.05"},
+		{0, "0.00"},
+		{-5, "-0.05"},
+		{-1234567, "-12345.67"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.amount.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  money.Amount
+		rate    float64
+		wantMul money.Amount
+		wantDiv money.Amount
+	}{
+		{"tax rate", 10000, 0.11, 1100, 90909},
+		{"rounds half away from zero", 5, 0.5, 3, 10},
+		{"negative rounds half away from zero", -5, 0.5, -3, -10},
+		{"inclusive tax factor", 11100, 1.11, 12321, 10000},
+		{"factor of one", 999, 1, 999, 999},
+		{"zero rate", 10000, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.MulRate(tt.rate); got != tt.wantMul {
+				t.Errorf("MulRate(%v) = %d, want %d", tt.rate, got, tt.wantMul)
+			}
+			if got := tt.amount.DivRate(tt.rate); got != tt.wantDiv {
+				t.Errorf("DivRate(%v) = %d, want %d", tt.rate, got, tt.wantDiv)
+			}
+		})
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  money.Amount
+		weights []money.Amount
+		want    []money.Amount
+	}{
+		{"even split", 1000, []money.Amount{1, 1}, []money.Amount{500, 500}},
+		{"proportional split", 1000, []money.Amount{3000, 1000}, []money.Amount{750, 250}},
+		{"remainder goes to the first shares", 100, []money.Amount{1, 1, 1}, []money.Amount{34, 33, 33}},
+		{"two minor units of remainder", 200, []money.Amount{1, 1, 1}, []money.Amount{67, 67, 66}},
+		{"negative amount", -100, []money.Amount{1, 1, 1}, []money.Amount{-34, -33, -33}},
+		{"zero and negative weights get nothing", 100, []money.Amount{0, 1, -5, 1}, []money.Amount{0, 50, 0, 50}},
+		{"remainder skips zero weights", 101, []money.Amount{0, 1, 1}, []money.Amount{0, 51, 50}},
+		{"all weights zero", 100, []money.Amount{0, 0}, []money.Amount{0, 0}},
+		{"no weights", 100, nil, []money.Amount{}},
+		{"zero amount", 0, []money.Amount{1, 2}, []money.Amount{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.amount.Allocate(tt.weights)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Allocate(%v) = %v, want %v", tt.weights, got, tt.want)
+			}
+		})
+	}
+}
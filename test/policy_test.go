@@ -0,0 +1,282 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testRoles = config.RoleSettings{
+	SuperUserRole:   "super",
+	CompanyUserRole: "company",
+	BranchUserRole:  "branch",
+	StoreUserRole:   "store",
+}
+
+// servedServices are the services cmd/server registers
+var servedServices = []grpc.ServiceDesc{
+	pb.PosCashDrawerService_ServiceDesc,
+	pb.PosCustomerService_ServiceDesc,
+	pb.PosInvoiceService_ServiceDesc,
+	pb.PosOnlinePaymentService_ServiceDesc,
+	pb.PosPaymentMethodService_ServiceDesc,
+	pb.PosReturnService_ServiceDesc,
+	pb.PosTaxRateService_ServiceDesc,
+	pb.PosPromotionRuleService_ServiceDesc,
+	pb.PosSaleService_ServiceDesc,
+	pb.PosReceiptService_ServiceDesc,
+	pb.PosReportService_ServiceDesc,
+}
+
+func newTestPolicy(t *testing.T) *policy.Policy {
+	t.Helper()
+
+	p, err := policy.New(testRoles, policy.DefaultRules())
+	if err != nil {
+		t.Fatalf("default rules are invalid: %v", err)
+	}
+	return p
+}
+
+func TestDefaultRulesCoverEveryServedMethod(t *testing.T) {
+	rules := policy.DefaultRules()
+
+	served := map[string]bool{}
+	for _, desc := range servedServices {
+		for _, method := range desc.Methods {
+			fullMethod := "/" + desc.ServiceName + "/" + method.MethodName
+			served[fullMethod] = true
+
+			if _, ok := rules[fullMethod]; !ok {
+				t.Errorf("%s has no permission rule", fullMethod)
+			}
+		}
+	}
+
+	for method := range rules {
+		if !served[method] {
+			t.Errorf("rule %s names a method the server does not serve", method)
+		}
+	}
+}
+
+func TestPolicyDecide(t *testing.T) {
+	p := newTestPolicy(t)
+
+	tests := []struct {
+		name      string
+		method    string
+		roleName  string
+		wantLevel string
+		wantScope policy.Scope
+		wantCode  codes.Code
+	}{
+		{"store user creates a receipt", "/pos.PosReceiptService/CreatePosReceipt", "store", policy.STORE_USER, policy.SCOPE_ANY, codes.OK},
+		{"branch user cannot create a receipt", "/pos.PosReceiptService/CreatePosReceipt", "branch", "", "", codes.PermissionDenied},
+		{"company user reads a receipt of the company", "/pos.PosReceiptService/ReadPosReceipt", "company", policy.COMPANY_USER, policy.SCOPE_COMPANY, codes.OK},
+		{"store user reads a receipt of the store", "/pos.PosReceiptService/ReadPosReceipt", "store", policy.STORE_USER, policy.SCOPE_STORE, codes.OK},
+		{"store user voids a receipt of the store", "/pos.PosReceiptService/VoidPosReceipt", "store", policy.STORE_USER, policy.SCOPE_STORE, codes.OK},
+		{"company user cannot void a receipt", "/pos.PosReceiptService/VoidPosReceipt", "company", "", "", codes.PermissionDenied},
+		{"store user reads an invoice of the branch", "/pos.PosInvoiceService/ReadPosInvoice", "store", policy.STORE_USER, policy.SCOPE_BRANCH, codes.OK},
+		{"store user lists invoices", "/pos.PosInvoiceService/ReadAllPosInvoices", "store", policy.STORE_USER, policy.SCOPE_ANY, codes.OK},
+		{"store user cannot update an invoice", "/pos.PosInvoiceService/UpdatePosInvoice", "store", "", "", codes.PermissionDenied},
+		{"store user reads a company tax rate", "/pos.PosTaxRateService/ReadPosTaxRate", "store", policy.STORE_USER, policy.SCOPE_COMPANY, codes.OK},
+		{"branch user cannot create a tax rate", "/pos.PosTaxRateService/CreatePosTaxRate", "branch", "", "", codes.PermissionDenied},
+		{"cashier closes their own session", "/pos.PosCashDrawerService/CloseDrawerSession", "store", policy.STORE_USER, policy.SCOPE_OWNER, codes.OK},
+		{"store user cannot list sessions", "/pos.PosCashDrawerService/ReadAllDrawerSessions", "store", "", "", codes.PermissionDenied},
+		{"super user is not in the sales table", "/pos.PosSaleService/ReadAllPosSales", "super", "", "", codes.PermissionDenied},
+		{"unknown role", "/pos.PosSaleService/ReadAllPosSales", "cleaner", "", "", codes.PermissionDenied},
+		{"empty role", "/pos.PosSaleService/ReadAllPosSales", "", "", "", codes.PermissionDenied},
+		{"method without a rule", "/pos.PosSaleService/ExportPosSales", "company", "", "", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access, err := p.Decide(tt.method, tt.roleName)
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("Decide() code = %v, want %v (err %v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if access.Level != tt.wantLevel || access.Scope != tt.wantScope {
+				t.Errorf("Decide() = %s/%s, want %s/%s", access.Level, access.Scope, tt.wantLevel, tt.wantScope)
+			}
+			if access.RoleName != tt.roleName || access.Method != tt.method {
+				t.Errorf("Decide() = %+v, want the role name and method of the call", access)
+			}
+		})
+	}
+}
+
+func TestAccessAuthorize(t *testing.T) {
+	caller := &pb.JWTPayload{UserId: "user-1", CompanyId: "company-1", BranchId: "branch-1", StoreId: "store-1"}
+
+	tests := []struct {
+		name     string
+		scope    policy.Scope
+		resource policy.Resource
+		allowed  bool
+	}{
+		{"any scope ignores the record", policy.SCOPE_ANY, policy.Resource{}, true},
+		{"same company", policy.SCOPE_COMPANY, policy.Resource{CompanyID: "company-1"}, true},
+		{"other company", policy.SCOPE_COMPANY, policy.Resource{CompanyID: "company-2"}, false},
+		{"same branch", policy.SCOPE_BRANCH, policy.Resource{CompanyID: "company-1", BranchID: "branch-1"}, true},
+		{"other branch of the same company", policy.SCOPE_BRANCH, policy.Resource{CompanyID: "company-1", BranchID: "branch-2"}, false},
+		{"same store", policy.SCOPE_STORE, policy.Resource{BranchID: "branch-1", StoreID: "store-1"}, true},
+		{"other store of the same branch", policy.SCOPE_STORE, policy.Resource{BranchID: "branch-1", StoreID: "store-2"}, false},
+		{"own record", policy.SCOPE_OWNER, policy.Resource{BranchID: "branch-1", OwnerID: "user-1"}, true},
+		{"record of a colleague", policy.SCOPE_OWNER, policy.Resource{BranchID: "branch-1", OwnerID: "user-2"}, false},
+		{"record without the scoped field", policy.SCOPE_BRANCH, policy.Resource{CompanyID: "company-1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &policy.Access{Method: "/pos.Test/Method", Level: policy.STORE_USER, Scope: tt.scope, Payload: caller}
+
+			err := access.Authorize(tt.resource)
+
+			if tt.allowed && err != nil {
+				t.Fatalf("Authorize() = %v, want allowed", err)
+			}
+			if !tt.allowed && status.Code(err) != codes.PermissionDenied {
+				t.Fatalf("Authorize() = %v, want PermissionDenied", err)
+			}
+		})
+	}
+}
+
+func TestPolicyLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	listInvoices := "/pos.PosInvoiceService/ReadAllPosInvoices"
+	branchOnlyFile := writeFile("branch_only.yaml", "rules:\n  "+listInvoices+":\n    branch_user: any\n")
+	unknownMethodFile := writeFile("unknown.yaml", "rules:\n  /pos.PosInvoiceService/ExportPosInvoices:\n    branch_user: any\n")
+
+	tests := []struct {
+		name        string
+		settings    config.PolicySettings
+		wantErr     bool
+		storeAccess bool
+	}{
+		{"defaults", config.PolicySettings{}, false, true},
+		{"file replaces the rule", config.PolicySettings{File: branchOnlyFile}, false, false},
+		{"inline rules win over the file", config.PolicySettings{
+			File:  branchOnlyFile,
+			Rules: map[string]map[string]string{listInvoices: {"store_user": "any"}},
+		}, false, true},
+		{"unknown method in the file", config.PolicySettings{File: unknownMethodFile}, true, false},
+		{"unknown method inline", config.PolicySettings{Rules: map[string]map[string]string{"/pos.PosInvoiceService/ExportPosInvoices": {"store_user": "any"}}}, true, false},
+		{"unknown role", config.PolicySettings{Rules: map[string]map[string]string{listInvoices: {"cashier": "any"}}}, true, false},
+		{"unknown scope", config.PolicySettings{Rules: map[string]map[string]string{listInvoices: {"store_user": "region"}}}, true, false},
+		{"rule without roles", config.PolicySettings{Rules: map[string]map[string]string{listInvoices: {}}}, true, false},
+		{"missing file", config.PolicySettings{File: filepath.Join(dir, "missing.yaml")}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := policy.Load(&config.Settings{Roles: testRoles, Policy: tt.settings})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			_, err = p.Decide(listInvoices, "store")
+			if (err == nil) != tt.storeAccess {
+				t.Errorf("store user listing invoices: err = %v, want allowed %v", err, tt.storeAccess)
+			}
+		})
+	}
+}
+
+type fakeRoleResolver struct {
+	roleNames map[string]string
+	calls     int
+}
+
+func (f *fakeRoleResolver) GetPosRoleById(ctx context.Context, id string, jwtPayload *pb.JWTPayload) (*pb.ReadPosRoleResponse, error) {
+	f.calls++
+	roleName, ok := f.roleNames[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "role not found")
+	}
+	return &pb.ReadPosRoleResponse{PosRole: &pb.PosRole{RoleId: id, RoleName: roleName}}, nil
+}
+
+func TestPolicyUnaryServerInterceptor(t *testing.T) {
+	withRole := func(roleID string) context.Context {
+		return auth.WithIdentity(context.Background(), &auth.Identity{
+			Payload: &pb.JWTPayload{UserId: "user-1", Role: roleID, CompanyId: "company-1", BranchId: "branch-1", StoreId: "store-1"},
+			Token:   "token",
+		})
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		method       string
+		wantCode     codes.Code
+		wantHandled  bool
+		wantLevel    string
+		wantResolved bool
+	}{
+		{"health check needs no role", context.Background(), "/grpc.health.v1.Health/Check", codes.OK, true, "", false},
+		{"store user creates a receipt", withRole("role-store"), "/pos.PosReceiptService/CreatePosReceipt", codes.OK, true, policy.STORE_USER, true},
+		{"branch user creates a receipt", withRole("role-branch"), "/pos.PosReceiptService/CreatePosReceipt", codes.PermissionDenied, false, "", true},
+		{"method without a rule is denied before the role lookup", withRole("role-store"), "/pos.PosSaleService/ExportPosSales", codes.PermissionDenied, false, "", false},
+		{"call without identity", context.Background(), "/pos.PosSaleService/ReadAllPosSales", codes.Unauthenticated, false, "", false},
+		{"role the company service does not know", withRole("role-missing"), "/pos.PosSaleService/ReadAllPosSales", codes.NotFound, false, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &fakeRoleResolver{roleNames: map[string]string{"role-store": "store", "role-branch": "branch"}}
+			interceptor := policy.UnaryServerInterceptor(newTestPolicy(t), resolver)
+
+			var handled bool
+			var access *policy.Access
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+				access, _ = policy.FromContext(ctx)
+				return "ok", nil
+			}
+
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v (err %v)", code, tt.wantCode, err)
+			}
+			if handled != tt.wantHandled {
+				t.Fatalf("handler called = %v, want %v", handled, tt.wantHandled)
+			}
+			if (resolver.calls > 0) != tt.wantResolved {
+				t.Errorf("role resolved = %v, want %v", resolver.calls > 0, tt.wantResolved)
+			}
+			if tt.wantLevel != "" && (access == nil || access.Level != tt.wantLevel || access.Payload == nil) {
+				t.Errorf("access in context = %+v, want level %s with the caller", access, tt.wantLevel)
+			}
+		})
+	}
+}
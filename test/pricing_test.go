@@ -0,0 +1,279 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/pricing"
+
+	"github.com/google/uuid"
+)
+
+var (
+	pricingNow     = time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	pricingStoreID = uuid.MustParse("00000000-0000-0000-0000-0000000000a1")
+	otherStoreID   = uuid.MustParse("00000000-0000-0000-0000-0000000000a2")
+)
+
+// pricingRule returns an active rule of the given type running around pricingNow
+func pricingRule(id string, ruleType string, productIDs string, set func(rule *entity.PosPromotionRule)) entity.PosPromotionRule {
+	rule := entity.PosPromotionRule{
+		PromotionRuleID: uuid.MustParse(id),
+		RuleType:        ruleType,
+		ProductIDs:      productIDs,
+		StartDate:       pricingNow.Add(-24 * time.Hour),
+		EndDate:         pricingNow.Add(24 * time.Hour),
+		Active:          true,
+	}
+	if set != nil {
+		set(&rule)
+	}
+	return rule
+}
+
+type pricedLine struct {
+	discount    money.Amount
+	promotionID string
+}
+
+func TestPricingEngine(t *testing.T) {
+	const (
+		percentID = "00000000-0000-0000-0000-000000000001"
+		fixedID   = "00000000-0000-0000-0000-000000000002"
+		buyGetID  = "00000000-0000-0000-0000-000000000003"
+		bundleID  = "00000000-0000-0000-0000-000000000004"
+		receiptID = "00000000-0000-0000-0000-000000000005"
+		otherID   = "00000000-0000-0000-0000-000000000006"
+	)
+
+	tests := []struct {
+		name        string
+		rules       []entity.PosPromotionRule
+		lines       []pricing.Line
+		want        []pricedLine
+		wantReceipt string
+	}{
+		{
+			name:  "no promotion",
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 2}},
+			want:  []pricedLine{{0, ""}},
+		},
+		{
+			name: "percentage",
+			rules: []entity.PosPromotionRule{pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+				r.DiscountRate = 0.1
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 2}, {ProductID: "b", UnitPrice: 500, Quantity: 1}},
+			want:  []pricedLine{{200, percentID}, {0, ""}},
+		},
+		{
+			name: "percentage rounds half away from zero",
+			rules: []entity.PosPromotionRule{pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+				r.DiscountRate = 0.125
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1, Quantity: 4}},
+			want:  []pricedLine{{1, percentID}},
+		},
+		{
+			name: "percentage above 100% stops at the line gross",
+			rules: []entity.PosPromotionRule{pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+				r.DiscountRate = 1.5
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 2}},
+			want:  []pricedLine{{2000, percentID}},
+		},
+		{
+			name: "fixed amount per unit",
+			rules: []entity.PosPromotionRule{pricingRule(fixedID, entity.PROMOTION_RULE_FIXED_AMOUNT, "a", func(r *entity.PosPromotionRule) {
+				r.DiscountAmount = 150
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 3}},
+			want:  []pricedLine{{450, fixedID}},
+		},
+		{
+			name: "fixed amount stops at the line gross",
+			rules: []entity.PosPromotionRule{pricingRule(fixedID, entity.PROMOTION_RULE_FIXED_AMOUNT, "a", func(r *entity.PosPromotionRule) {
+				r.DiscountAmount = 1500
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 2}},
+			want:  []pricedLine{{2000, fixedID}},
+		},
+		{
+			name: "buy two get one free",
+			rules: []entity.PosPromotionRule{pricingRule(buyGetID, entity.PROMOTION_RULE_BUY_X_GET_Y, "a", func(r *entity.PosPromotionRule) {
+				r.BuyQuantity = 2
+				r.GetQuantity = 1
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 7}},
+			want:  []pricedLine{{2000, buyGetID}},
+		},
+		{
+			name: "buy x get y without enough items",
+			rules: []entity.PosPromotionRule{pricingRule(buyGetID, entity.PROMOTION_RULE_BUY_X_GET_Y, "a", func(r *entity.PosPromotionRule) {
+				r.BuyQuantity = 2
+				r.GetQuantity = 1
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 2}},
+			want:  []pricedLine{{0, ""}},
+		},
+		{
+			name: "best line promotion wins",
+			rules: []entity.PosPromotionRule{
+				pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+					r.DiscountRate = 0.1
+				}),
+				pricingRule(fixedID, entity.PROMOTION_RULE_FIXED_AMOUNT, "a", func(r *entity.PosPromotionRule) {
+					r.DiscountAmount = 150
+				}),
+			},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 2}},
+			want:  []pricedLine{{300, fixedID}},
+		},
+		{
+			name: "bundle saving is split by unit price",
+			rules: []entity.PosPromotionRule{pricingRule(bundleID, entity.PROMOTION_RULE_BUNDLE, "a,b", func(r *entity.PosPromotionRule) {
+				r.BundlePrice = 1200
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 2}, {ProductID: "b", UnitPrice: 500, Quantity: 3}},
+			want:  []pricedLine{{400, bundleID}, {200, bundleID}},
+		},
+		{
+			name: "bundle remainder goes to the first line",
+			rules: []entity.PosPromotionRule{pricingRule(bundleID, entity.PROMOTION_RULE_BUNDLE, "a,b", func(r *entity.PosPromotionRule) {
+				r.BundlePrice = 1000
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 700, Quantity: 1}, {ProductID: "b", UnitPrice: 700, Quantity: 1}},
+			want:  []pricedLine{{200, bundleID}, {200, bundleID}},
+		},
+		{
+			name: "bundle skips lines that have a line promotion",
+			rules: []entity.PosPromotionRule{
+				pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+					r.DiscountRate = 0.1
+				}),
+				pricingRule(bundleID, entity.PROMOTION_RULE_BUNDLE, "a,b", func(r *entity.PosPromotionRule) {
+					r.BundlePrice = 1200
+				}),
+			},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 1}, {ProductID: "b", UnitPrice: 500, Quantity: 1}},
+			want:  []pricedLine{{100, percentID}, {0, ""}},
+		},
+		{
+			name: "bundle priced above its products is ignored",
+			rules: []entity.PosPromotionRule{pricingRule(bundleID, entity.PROMOTION_RULE_BUNDLE, "a,b", func(r *entity.PosPromotionRule) {
+				r.BundlePrice = 2000
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 1}, {ProductID: "b", UnitPrice: 500, Quantity: 1}},
+			want:  []pricedLine{{0, ""}, {0, ""}},
+		},
+		{
+			name: "receipt threshold is spread by net amount",
+			rules: []entity.PosPromotionRule{pricingRule(receiptID, entity.PROMOTION_RULE_RECEIPT_THRESHOLD, "", func(r *entity.PosPromotionRule) {
+				r.MinSubtotal = 5000
+				r.DiscountRate = 0.1
+			})},
+			lines:       []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 4}, {ProductID: "b", UnitPrice: 2000, Quantity: 1}},
+			want:        []pricedLine{{400, ""}, {200, ""}},
+			wantReceipt: receiptID,
+		},
+		{
+			name: "receipt threshold counts the net after line promotions",
+			rules: []entity.PosPromotionRule{
+				pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+					r.DiscountRate = 0.5
+				}),
+				pricingRule(receiptID, entity.PROMOTION_RULE_RECEIPT_THRESHOLD, "", func(r *entity.PosPromotionRule) {
+					r.MinSubtotal = 5000
+					r.DiscountAmount = 500
+				}),
+			},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 6}},
+			want:  []pricedLine{{3000, percentID}},
+		},
+		{
+			name: "receipt threshold stops at the basket net",
+			rules: []entity.PosPromotionRule{pricingRule(receiptID, entity.PROMOTION_RULE_RECEIPT_THRESHOLD, "", func(r *entity.PosPromotionRule) {
+				r.MinSubtotal = 1000
+				r.DiscountAmount = 5000
+			})},
+			lines:       []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 1}, {ProductID: "b", UnitPrice: 500, Quantity: 1}},
+			want:        []pricedLine{{1000, ""}, {500, ""}},
+			wantReceipt: receiptID,
+		},
+		{
+			name: "best receipt threshold wins",
+			rules: []entity.PosPromotionRule{
+				pricingRule(receiptID, entity.PROMOTION_RULE_RECEIPT_THRESHOLD, "", func(r *entity.PosPromotionRule) {
+					r.MinSubtotal = 1000
+					r.DiscountAmount = 100
+				}),
+				pricingRule(otherID, entity.PROMOTION_RULE_RECEIPT_THRESHOLD, "", func(r *entity.PosPromotionRule) {
+					r.MinSubtotal = 2000
+					r.DiscountRate = 0.1
+				}),
+			},
+			lines:       []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 3}},
+			want:        []pricedLine{{300, ""}},
+			wantReceipt: otherID,
+		},
+		{
+			name: "inactive, expired and other store rules are ignored",
+			rules: []entity.PosPromotionRule{
+				pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+					r.DiscountRate = 0.1
+					r.Active = false
+				}),
+				pricingRule(fixedID, entity.PROMOTION_RULE_FIXED_AMOUNT, "a", func(r *entity.PosPromotionRule) {
+					r.DiscountAmount = 100
+					r.EndDate = pricingNow.Add(-time.Hour)
+				}),
+				pricingRule(otherID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+					r.DiscountRate = 0.2
+					r.StoreID = &otherStoreID
+				}),
+			},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 1}},
+			want:  []pricedLine{{0, ""}},
+		},
+		{
+			name: "rule of the store applies",
+			rules: []entity.PosPromotionRule{pricingRule(percentID, entity.PROMOTION_RULE_PERCENTAGE, "a", func(r *entity.PosPromotionRule) {
+				r.DiscountRate = 0.2
+				r.StoreID = &pricingStoreID
+			})},
+			lines: []pricing.Line{{ProductID: "a", UnitPrice: 1000, Quantity: 1}},
+			want:  []pricedLine{{200, percentID}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := make([]*pricing.Line, len(tt.lines))
+			for i := range tt.lines {
+				line := tt.lines[i]
+				lines[i] = &line
+			}
+
+			engine := pricing.NewEngine(tt.rules, pricingStoreID.String(), "", pricingNow)
+			receiptPromotionID := engine.Price(lines)
+
+			if receiptPromotionID != tt.wantReceipt {
+				t.Errorf("Price() receipt promotion = %q, want %q", receiptPromotionID, tt.wantReceipt)
+			}
+
+			for i, line := range lines {
+				if line.Gross != line.UnitPrice.Times(line.Quantity) {
+					t.Errorf("line %d gross = %s, want %s", i, line.Gross, line.UnitPrice.Times(line.Quantity))
+				}
+				if line.Discount != tt.want[i].discount || line.PromotionID != tt.want[i].promotionID {
+					t.Errorf("line %d = %s/%q, want %s/%q", i, line.Discount, line.PromotionID, tt.want[i].discount, tt.want[i].promotionID)
+				}
+				if line.Net() < 0 {
+					t.Errorf("line %d net = %s, want at least zero", i, line.Net())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/auth"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/policy"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/service"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// seriesReportRepo answers every series query with its rows placed in the first bucket of the query
+type seriesReportRepo struct {
+	rows []dto.PosSalesSeriesRow
+}
+
+func (r *seriesReportRepo) ReadPosCashierSalesTotals(string, time.Time, time.Time) ([]dto.PosCashierSalesTotals, error) {
+	return nil, nil
+}
+
+func (r *seriesReportRepo) ReadPosCashierReturnTotals(string, time.Time, time.Time) ([]dto.PosCashierReturnTotals, error) {
+	return nil, nil
+}
+
+func (r *seriesReportRepo) ReadPosTenderTotals(string, string, string, time.Time, time.Time) ([]dto.PosTenderTotals, error) {
+	return nil, nil
+}
+
+func (r *seriesReportRepo) ReadPosSalesSeries(query dto.PosSalesSeriesQuery, roleName string, jwtPayload *pb.JWTPayload) ([]dto.PosSalesSeriesRow, error) {
+	rows := make([]dto.PosSalesSeriesRow, len(r.rows))
+	for i, row := range r.rows {
+		row.Bucket = query.From
+		rows[i] = row
+	}
+	return rows, nil
+}
+
+func (r *seriesReportRepo) RebuildPosSalesRollups(time.Time, time.Time) (int, error) {
+	return 0, nil
+}
+
+func seriesContext() context.Context {
+	payload := &pb.JWTPayload{UserId: "user-1", CompanyId: "company-1", BranchId: "branch-1"}
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{Payload: payload})
+	return policy.WithAccess(ctx, &policy.Access{
+		Method:   "/pos.PosReportService/GetSalesTimeSeries",
+		RoleName: "company",
+		Level:    policy.COMPANY_USER,
+		Scope:    policy.SCOPE_ANY,
+		Payload:  payload,
+	})
+}
+
+func TestSalesSeriesNetSales(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        []dto.PosSalesSeriesRow
+		wantReturns money.Amount
+		wantNet     money.Amount
+	}{
+		{
+			"sale without returns",
+			[]dto.PosSalesSeriesRow{{GrossSales: 10000, Discounts: 1000, Taxes: 900, Transactions: 1}},
+			0, 9000,
+		},
+		{
+			"exclusive tax sale refunded in full",
+			[]dto.PosSalesSeriesRow{
+				{GrossSales: 10000, Taxes: 1000, Transactions: 1},
+				{Returns: 11000, ReturnTaxes: 1000},
+			},
+			11000, 0,
+		},
+		{
+			"exclusive tax sale refunded in half",
+			[]dto.PosSalesSeriesRow{{GrossSales: 10000, Taxes: 1000, Returns: 5500, ReturnTaxes: 500, Transactions: 1}},
+			5500, 5000,
+		},
+		{
+			"inclusive tax sale refunded in full",
+			[]dto.PosSalesSeriesRow{{GrossSales: 11100, Taxes: 1100, Returns: 11100, Transactions: 1}},
+			11100, 0,
+		},
+		{
+			"refund of an exclusive tax sale of an earlier period",
+			[]dto.PosSalesSeriesRow{{Returns: 11000, ReturnTaxes: 1000}},
+			11000, -10000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &seriesReportRepo{rows: tt.rows}
+			reportService := service.NewPosReportService(repo, nil)
+
+			from := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+			resp, err := reportService.GetSalesTimeSeries(seriesContext(), &pb.GetSalesTimeSeriesRequest{
+				Interval: dto.SERIES_INTERVAL_DAY,
+				From:     timestamppb.New(from),
+				To:       timestamppb.New(from.AddDate(0, 0, 2)),
+			})
+			if err != nil {
+				t.Fatalf("GetSalesTimeSeries() error = %v", err)
+			}
+
+			if len(resp.Series) != 1 || len(resp.Series[0].Points) != 2 {
+				t.Fatalf("GetSalesTimeSeries() = %v, want one series of two days", resp.Series)
+			}
+
+			series := resp.Series[0]
+			if got := money.FromProto(series.Points[0].Totals.NetSales); got != tt.wantNet {
+				t.Errorf("first day net sales = %s, want %s", got, tt.wantNet)
+			}
+			if got := money.FromProto(series.Totals.Returns); got != tt.wantReturns {
+				t.Errorf("returns = %s, want the refunded %s", got, tt.wantReturns)
+			}
+			if got := money.FromProto(series.Totals.NetSales); got != tt.wantNet {
+				t.Errorf("period net sales = %s, want %s", got, tt.wantNet)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/money"
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/taxes"
+
+	"github.com/google/uuid"
+)
+
+var (
+	taxStoreID         = uuid.MustParse("00000000-0000-0000-0000-0000000000b1")
+	taxOtherStoreID    = uuid.MustParse("00000000-0000-0000-0000-0000000000b2")
+	taxCategoryID      = uuid.MustParse("00000000-0000-0000-0000-0000000000c1")
+	taxOtherCategoryID = uuid.MustParse("00000000-0000-0000-0000-0000000000c2")
+)
+
+func taxRate(name string, rate float64, inclusive bool, storeID *uuid.UUID, categoryID *uuid.UUID) entity.PosTaxRate {
+	return entity.PosTaxRate{
+		TaxRateID:    uuid.New(),
+		TaxName:      name,
+		Rate:         rate,
+		TaxInclusive: inclusive,
+		StoreID:      storeID,
+		CategoryID:   categoryID,
+	}
+}
+
+func TestTaxRatePrecedence(t *testing.T) {
+	// Listed from least to most specific so the order of the rates does not decide the match
+	rates := []entity.PosTaxRate{
+		taxRate("company", 0.10, false, nil, nil),
+		taxRate("store", 0.11, false, &taxStoreID, nil),
+		taxRate("category", 0.12, false, nil, &taxCategoryID),
+		taxRate("store and category", 0.13, false, &taxStoreID, &taxCategoryID),
+		taxRate("other store and category", 0.14, false, &taxOtherStoreID, &taxCategoryID),
+	}
+
+	tests := []struct {
+		name       string
+		rates      []entity.PosTaxRate
+		storeID    uuid.UUID
+		categoryID uuid.UUID
+		want       string
+	}{
+		{"store and category beat everything", rates, taxStoreID, taxCategoryID, "store and category"},
+		{"store and category rate of another store", rates, taxOtherStoreID, taxCategoryID, "other store and category"},
+		{"category of another store", rates, uuid.New(), taxCategoryID, "category"},
+		{"store without a category rate", rates, taxStoreID, taxOtherCategoryID, "store"},
+		{"company wide rate is the fallback", rates, uuid.New(), taxOtherCategoryID, "company"},
+		{"category beats store when both match alone", rates[:3], taxStoreID, taxCategoryID, "category"},
+		{"no rate applies", rates[1:2], taxOtherStoreID, taxOtherCategoryID, ""},
+		{"no rates", nil, taxStoreID, taxCategoryID, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate := taxes.NewEngine(tt.rates).RateFor(tt.storeID.String(), tt.categoryID.String())
+
+			var got string
+			if rate != nil {
+				got = rate.TaxName
+			}
+			if got != tt.want {
+				t.Errorf("RateFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaxCompute(t *testing.T) {
+	tests := []struct {
+		name        string
+		rate        *entity.PosTaxRate
+		lineTotal   money.Amount
+		wantAmount  money.Amount
+		wantTaxable money.Amount
+	}{
+		{"exclusive adds the tax on top", &entity.PosTaxRate{Rate: 0.11}, 10000, 1100, 10000},
+		{"exclusive rounds half away from zero", &entity.PosTaxRate{Rate: 0.1}, 15, 2, 15},
+		{"inclusive backs the tax out", &entity.PosTaxRate{Rate: 0.11, TaxInclusive: true}, 11100, 1100, 10000},
+		{"inclusive rounds the net amount", &entity.PosTaxRate{Rate: 0.11, TaxInclusive: true}, 10000, 991, 9009},
+		{"inclusive on a single minor unit", &entity.PosTaxRate{Rate: 0.1, TaxInclusive: true}, 1, 0, 1},
+		{"inclusive on a negative total", &entity.PosTaxRate{Rate: 0.11, TaxInclusive: true}, -11100, -1100, -10000},
+		{"zero rate", &entity.PosTaxRate{Rate: 0, TaxInclusive: true}, 10000, 0, 10000},
+		{"no rate", nil, 10000, 0, 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rates []entity.PosTaxRate
+			if tt.rate != nil {
+				rates = append(rates, *tt.rate)
+			}
+
+			tax := taxes.NewEngine(rates).Compute(taxStoreID.String(), taxCategoryID.String(), tt.lineTotal)
+
+			if tax.Amount != tt.wantAmount || tax.Taxable != tt.wantTaxable {
+				t.Errorf("Compute() = tax %s on %s, want tax %s on %s", tax.Amount, tax.Taxable, tt.wantAmount, tt.wantTaxable)
+			}
+			if (tax.TaxRate == nil) != (tt.rate == nil) {
+				t.Errorf("Compute() rate = %v, want a rate %v", tax.TaxRate, tt.rate != nil)
+			}
+			if tt.rate != nil && tt.rate.TaxInclusive && tax.Taxable+tax.Amount != tt.lineTotal {
+				t.Errorf("inclusive tax %s and taxable %s do not add up to the line total %s", tax.Amount, tax.Taxable, tt.lineTotal)
+			}
+		})
+	}
+}
+
+func TestTaxBreakdown(t *testing.T) {
+	vat := taxRate("VAT", 0.11, false, nil, nil)
+	luxury := taxRate("Luxury", 0.2, true, nil, nil)
+
+	breakdown := taxes.Breakdown([]taxes.LineTax{
+		{TaxRate: &vat, Taxable: 10000, Amount: 1100},
+		{Taxable: 500},
+		{TaxRate: &luxury, Taxable: 5000, Amount: 1000},
+		{TaxRate: &vat, Taxable: 2000, Amount: 220},
+	})
+
+	if len(breakdown) != 2 {
+		t.Fatalf("Breakdown() = %+v, want one entry per rate", breakdown)
+	}
+
+	if got := breakdown[0]; got.TaxName != "VAT" || got.TaxableAmount != 12000 || got.TaxAmount != 1320 || got.TaxInclusive {
+		t.Errorf("Breakdown()[0] = %+v, want VAT tax 13.20 on 120.00", got)
+	}
+
+	if got := breakdown[1]; got.TaxName != "Luxury" || got.TaxableAmount != 5000 || got.TaxAmount != 1000 || !got.TaxInclusive {
+		t.Errorf("Breakdown()[1] = %+v, want inclusive Luxury tax 10.00 on 50.00", got)
+	}
+}
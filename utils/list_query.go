@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Query parameters of the ReadAll routes that are not filters
+var listParams = map[string]bool{"limit": true, "page": true, "page_token": true, "estimate_count": true, "sort_by": true, "order": true, "from": true, "to": true}
+
+// ParseListQuery reads the filters, date range and order of a ReadAll route.
+// from and to take an RFC 3339 time or a date, order is asc or desc and every other parameter filters on the field it names.
+// Which fields can be filtered and sorted on is checked by the server.
+func ParseListQuery(values url.Values) (*pb.ListQuery, error) {
+	query := &pb.ListQuery{
+		Filters: map[string]string{},
+		SortBy:  values.Get("sort_by"),
+	}
+
+	switch values.Get("order") {
+	case "", "asc":
+	case "desc":
+		query.Descending = true
+	default:
+		return nil, fmt.Errorf("order must be asc or desc, got %q", values.Get("order"))
+	}
+
+	for name, target := range map[string]**timestamppb.Timestamp{"from": &query.From, "to": &query.To} {
+		value := values.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := parseListTime(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 time or a date like 2006-01-02, got %q", name, value)
+		}
+		*target = timestamppb.New(parsed)
+	}
+
+	for name, value := range values {
+		if listParams[name] {
+			continue
+		}
+		if len(value) != 1 || value[0] == "" {
+			return nil, fmt.Errorf("filter %s needs a single value", name)
+		}
+		query.Filters[name] = value[0]
+	}
+
+	return query, nil
+}
+
+func parseListTime(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
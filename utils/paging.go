@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Paging is the page a ReadAll route asks for.
+// limit and page read numbered pages, limit alone reads the first page by token and page_token the ones after it.
+type Paging struct {
+	Limit         int32
+	Page          int32
+	PageToken     string
+	EstimateCount bool
+}
+
+// ParsePaging reads limit, page, page_token and estimate_count, none of them is required
+func ParsePaging(values url.Values) (Paging, error) {
+	var paging Paging
+
+	if limit := values.Get("limit"); limit != "" {
+		value, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil || value <= 0 {
+			return paging, fmt.Errorf("limit must be a positive number, got %q", limit)
+		}
+		paging.Limit = int32(value)
+	}
+
+	if page := values.Get("page"); page != "" {
+		value, err := strconv.ParseInt(page, 10, 32)
+		if err != nil || value <= 0 {
+			return paging, fmt.Errorf("page must be a positive number, got %q", page)
+		}
+		paging.Page = int32(value)
+	}
+
+	paging.PageToken = values.Get("page_token")
+
+	if paging.Limit == 0 && (paging.Page > 0 || paging.PageToken != "") {
+		return paging, errors.New("page and page_token need a limit")
+	}
+	if paging.Page > 0 && paging.PageToken != "" {
+		return paging, errors.New("page and page_token cannot be combined")
+	}
+
+	if estimate := values.Get("estimate_count"); estimate != "" {
+		value, err := strconv.ParseBool(estimate)
+		if err != nil {
+			return paging, fmt.Errorf("estimate_count must be true or false, got %q", estimate)
+		}
+		paging.EstimateCount = value
+	}
+
+	return paging, nil
+}
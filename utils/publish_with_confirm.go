@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+// PublishWithConfirm publishes a persistent JSON message to the default exchange and waits for the broker to confirm it.
+// The channel must already be in confirm mode, confirms must be the channel registered with NotifyPublish
+// and returns the channel registered with NotifyReturn.
+// The message is mandatory, a message no queue is bound for comes back before its ack and is reported as an error.
+// published counts the messages sent on the channel, the broker numbers its confirms the same way.
+// Confirms and returns left over from an earlier publish that timed out are skipped by their delivery tag and message id,
+// so a late ack is never taken for the ack of this message.
+func PublishWithConfirm(ch *amqp.Channel, confirms <-chan amqp.Confirmation, returns <-chan amqp.Return, published *uint64, routingKey string, messageID string, body []byte, headers amqp.Table, timeout time.Duration) error {
+	// Drop what an earlier publish left behind, for instance after it timed out
+	for drained := false; !drained; {
+		select {
+		case <-returns:
+		default:
+			drained = true
+		}
+	}
+
+	err := ch.Publish(
+		"",         // exchange
+		routingKey, // routing key
+		true,       // mandatory
+		false,      // immediate
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			MessageId:    messageID,
+			Headers:      headers,
+			Timestamp:    time.Now(),
+			Body:         body,
+		})
+	if err != nil {
+		return err
+	}
+	*published++
+	deliveryTag := *published
+
+	var returned *amqp.Return
+	deadline := time.After(timeout)
+	for {
+		select {
+		case confirm, ok := <-confirms:
+			if !ok {
+				return errors.New("channel closed before the broker confirmed the message")
+			}
+			if confirm.DeliveryTag != deliveryTag {
+				// A late confirm of a message that timed out earlier
+				continue
+			}
+			if !confirm.Ack {
+				return fmt.Errorf("broker rejected message %s", messageID)
+			}
+			// The broker sends the return of an unroutable message before acking it
+			for drained := returned != nil; !drained; {
+				select {
+				case r := <-returns:
+					if r.MessageId == messageID {
+						returned = &r
+						drained = true
+					}
+				default:
+					drained = true
+				}
+			}
+			if returned != nil {
+				return fmt.Errorf("broker returned message %s: %d %s, no queue is bound to %q", returned.MessageId, returned.ReplyCode, returned.ReplyText, routingKey)
+			}
+			return nil
+		case r := <-returns:
+			if r.MessageId == messageID {
+				returned = &r
+			}
+		case <-deadline:
+			return fmt.Errorf("timed out waiting for broker confirmation of message %s", messageID)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-sales-service/api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ParseSalesSeriesRequest reads the query parameters of the sales time series route.
+// from and to take an RFC 3339 time or a date, a date starts at midnight in time_zone.
+// interval, group_by and the ids are checked by the server.
+func ParseSalesSeriesRequest(values url.Values) (*pb.GetSalesTimeSeriesRequest, error) {
+	req := &pb.GetSalesTimeSeriesRequest{
+		Interval:        values.Get("interval"),
+		TimeZone:        values.Get("time_zone"),
+		GroupBy:         values.Get("group_by"),
+		BranchId:        values.Get("branch_id"),
+		StoreId:         values.Get("store_id"),
+		PaymentMethodId: values.Get("payment_method_id"),
+	}
+
+	location := time.UTC
+	if req.TimeZone != "" {
+		loaded, err := time.LoadLocation(req.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q", req.TimeZone)
+		}
+		location = loaded
+	}
+
+	for name, target := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		value := values.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := parseSeriesTime(value, location)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 time or a date like 2006-01-02, got %q", name, value)
+		}
+		*target = timestamppb.New(parsed)
+	}
+
+	if compare := values.Get("compare_previous"); compare != "" {
+		value, err := strconv.ParseBool(compare)
+		if err != nil {
+			return nil, fmt.Errorf("compare_previous must be true or false, got %q", compare)
+		}
+		req.ComparePrevious = value
+	}
+
+	return req, nil
+}
+
+func parseSeriesTime(value string, location *time.Location) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	return time.ParseInLocation(time.DateOnly, value, location)
+}
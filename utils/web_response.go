@@ -1,11 +1,18 @@
 package utils
 
+import (
+	"context"
+
+	"github.com/Andrewalifb/alpha-pos-system-sales-service/pkg/logging"
+)
+
 type Response struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Error   any    `json:"error,omitempty"`
-	Data    any    `json:"data,omitempty"`
-	Meta    any    `json:"meta,omitempty"`
+	Status    bool   `json:"status"`
+	Message   string `json:"message"`
+	Error     any    `json:"error,omitempty"`
+	Data      any    `json:"data,omitempty"`
+	Meta      any    `json:"meta,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
 }
 
 type EmptyObj struct{}
@@ -28,3 +35,9 @@ func BuildResponseFailed(message string, err string, data any) Response {
 	}
 	return res
 }
+
+// WithRequestID adds the request id of ctx so a failed call can be found in the logs
+func (r Response) WithRequestID(ctx context.Context) Response {
+	r.RequestID = logging.RequestID(ctx)
+	return r
+}